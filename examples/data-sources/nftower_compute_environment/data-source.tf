@@ -5,4 +5,8 @@ data "nftower_workspace" "foo" {
 data "nftower_compute_environment" "foo" {
   name         = "foo"
   workspace_id = data.nftower_workspace.foo.id
-}
\ No newline at end of file
+}
+data "nftower_compute_environment" "primary" {
+  primary      = true
+  workspace_id = data.nftower_workspace.foo.id
+}
@@ -0,0 +1,2 @@
+# Actions can be imported using workspace_id/action_id
+terraform import nftower_action.example 123456789/5dkSkfj3kdJdk3S
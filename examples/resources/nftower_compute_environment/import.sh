@@ -0,0 +1,2 @@
+# Compute environments can be imported using workspace_id/compute_env_id
+terraform import nftower_compute_environment.example 123456789/4Bk4kSDFjd9fjdSkd
@@ -27,6 +27,24 @@ resource "nftower_compute_environment" "example-awsbatch" {
   }
 }
 
+resource "nftower_compute_environment" "example-awsbatch-forge" {
+  name           = "example-awsbatch-forge"
+  workspace_id   = nftower_workspace.example.id
+  credentials_id = nftower_credentials.aws.id
+
+  aws_batch {
+    region   = "eu-west-1"
+    work_dir = "s3://my-nf-workdir"
+
+    forge {
+      type           = "SPOT"
+      max_cpus       = 256
+      instance_types = ["m5", "c5"]
+      ebs_auto_scale = true
+    }
+  }
+}
+
 resource "nftower_credentials" "lsf_submission_ssh_key" {
   name         = "lsf-submission-ssh-key"
   workspace_id = nftower_workspace.example.id
@@ -47,3 +65,119 @@ resource "nftower_compute_environment" "example-lsfplatform" {
     head_queue    = "head"
   }
 }
+
+resource "nftower_compute_environment" "example-slurmplatform" {
+  name           = "example-slurmplatform"
+  workspace_id   = nftower_workspace.example.id
+  credentials_id = nftower_credentials.lsf_submission_ssh_key.id
+
+  slurm_platform {
+    work_dir                   = "/scratch/nextflow/work"
+    launch_dir                 = "/scratch/nextflow/launch"
+    user_name                  = "nextflow"
+    host_name                  = "login.hpc.example.com"
+    head_queue                 = "head"
+    compute_queue              = "compute"
+    head_job_options           = "--mem=4G"
+    propagate_head_job_options = false
+  }
+}
+
+resource "nftower_credentials" "google" {
+  name         = "google-creds"
+  workspace_id = nftower_workspace.example.id
+
+  google {
+    data = file("service-account-key.json")
+  }
+}
+
+resource "nftower_compute_environment" "example-googlebatch" {
+  name           = "example-googlebatch"
+  workspace_id   = nftower_workspace.example.id
+  credentials_id = nftower_credentials.google.id
+
+  google_batch {
+    location = "europe-west2"
+    work_dir = "gs://my-nf-workdir"
+    spot     = true
+  }
+}
+
+resource "nftower_credentials" "azure" {
+  name         = "azure-creds"
+  workspace_id = nftower_workspace.example.id
+
+  azure {
+    batch_name   = "mybatchaccount"
+    batch_key    = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+    storage_name = "mystorageaccount"
+    storage_key  = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+  }
+}
+
+resource "nftower_compute_environment" "example-azurebatch" {
+  name           = "example-azurebatch"
+  workspace_id   = nftower_workspace.example.id
+  credentials_id = nftower_credentials.azure.id
+
+  azure_batch {
+    region   = "uksouth"
+    work_dir = "az://my-container/work"
+
+    forge {
+      vm_type  = "Standard_D4_v3"
+      vm_count = 4
+    }
+  }
+}
+
+resource "nftower_credentials" "kubernetes" {
+  name         = "kubernetes-creds"
+  workspace_id = nftower_workspace.example.id
+
+  kubernetes {
+    certificate = file("client.crt")
+    private_key = file("client.key")
+  }
+}
+
+resource "nftower_compute_environment" "example-k8splatform" {
+  name           = "example-k8splatform"
+  workspace_id   = nftower_workspace.example.id
+  credentials_id = nftower_credentials.kubernetes.id
+
+  k8s_platform {
+    server             = "https://k8s.example.com:6443"
+    ssl_cert           = file("ca.crt")
+    namespace          = "tower-nf"
+    storage_claim_name = "tower-scratch"
+    storage_mount_path = "/scratch"
+    work_dir           = "/scratch/work"
+  }
+}
+
+resource "nftower_credentials" "tower_agent" {
+  name         = "tower-agent-creds"
+  workspace_id = nftower_workspace.example.id
+
+  tower_agent {
+    connection_id = "my-connection-id"
+    work_dir      = "/scratch/nextflow/work"
+  }
+}
+
+resource "nftower_compute_environment" "example-slurmplatform-agent" {
+  name           = "example-slurmplatform-agent"
+  workspace_id   = nftower_workspace.example.id
+  credentials_id = nftower_credentials.tower_agent.id
+
+  slurm_platform {
+    work_dir                   = "/scratch/nextflow/work"
+    launch_dir                 = "/scratch/nextflow/launch"
+    head_queue                 = "head"
+    compute_queue              = "compute"
+    head_job_options           = "--mem=4G"
+    propagate_head_job_options = false
+  }
+}
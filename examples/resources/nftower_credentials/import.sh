@@ -0,0 +1,4 @@
+# Credentials can be imported using workspace_id/credentials_id.
+# Secret values are never returned by Tower and must be set in the configuration.
+# The first apply after an import writes them to Tower again.
+terraform import nftower_credentials.example 123456789/3zDjfSDkfj4kdfjsX
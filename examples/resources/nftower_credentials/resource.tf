@@ -22,3 +22,77 @@ resource "nftower_credentials" "github" {
     access_token = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn" // a personal access token (PAT)
   }
 }
+
+resource "nftower_credentials" "google" {
+  name         = "google-creds"
+  workspace_id = nftower_workspace.example.id
+
+  google {
+    data = file("service-account-key.json")
+  }
+}
+
+resource "nftower_credentials" "azure" {
+  name         = "azure-creds"
+  workspace_id = nftower_workspace.example.id
+
+  azure {
+    batch_name   = "mybatchaccount"
+    batch_key    = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+    storage_name = "mystorageaccount"
+    storage_key  = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+  }
+}
+
+resource "nftower_credentials" "azure_entra" {
+  name         = "azure-entra-creds"
+  workspace_id = nftower_workspace.example.id
+
+  azure {
+    batch_name    = "mybatchaccount"
+    storage_name  = "mystorageaccount"
+    tenant_id     = "00000000-0000-0000-0000-000000000000"
+    client_id     = "11111111-1111-1111-1111-111111111111"
+    client_secret = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+  }
+}
+
+resource "nftower_credentials" "bitbucket" {
+  name         = "bitbucket-creds"
+  workspace_id = nftower_workspace.example.id
+
+  bitbucket {
+    username     = "my-user"
+    app_password = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+  }
+}
+
+resource "nftower_credentials" "codecommit" {
+  name         = "codecommit-creds"
+  workspace_id = nftower_workspace.example.id
+
+  codecommit {
+    access_key = "ABDFRGTEDRFS"
+    secret_key = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn"
+    base_url   = "https://git-codecommit.eu-west-1.amazonaws.com"
+  }
+}
+
+resource "nftower_credentials" "kubernetes" {
+  name         = "kubernetes-creds"
+  workspace_id = nftower_workspace.example.id
+
+  kubernetes {
+    token = "sdkjdlgkdjflgkdglkdnflsrkgdlvkslgkdn" // a service account token
+  }
+}
+
+resource "nftower_credentials" "tower_agent" {
+  name         = "tower-agent-creds"
+  workspace_id = nftower_workspace.example.id
+
+  tower_agent {
+    connection_id = "my-connection-id" // the id passed to tw-agent on the cluster
+    work_dir      = "/scratch/work"
+  }
+}
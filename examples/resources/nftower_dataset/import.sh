@@ -0,0 +1,2 @@
+# Datasets can be imported using workspace_id/dataset_id
+terraform import nftower_dataset.example 123456789/2sXkdj3Kdfj3kd
@@ -0,0 +1,2 @@
+# Dataset versions can be imported using workspace_id/dataset_id:version
+terraform import nftower_dataset_version.example 123456789/2sXkdj3Kdfj3kd:1
@@ -0,0 +1,2 @@
+# Organization members can be imported using their member id
+terraform import nftower_organization_member.example 12345
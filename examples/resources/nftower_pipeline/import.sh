@@ -0,0 +1,2 @@
+# Pipelines can be imported using workspace_id/pipeline_id
+terraform import nftower_pipeline.example 123456789/987654321
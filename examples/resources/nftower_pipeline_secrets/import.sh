@@ -0,0 +1,3 @@
+# Pipeline secrets can be imported using workspace_id/secret_id.
+# The secret value is never returned by Tower and must be set in the configuration.
+terraform import nftower_pipeline_secrets.example 123456789/12345
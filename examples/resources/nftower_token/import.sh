@@ -0,0 +1,3 @@
+# Tokens can be imported using their id.
+# The token value is only returned on creation so will be empty once imported.
+terraform import nftower_token.example 12345
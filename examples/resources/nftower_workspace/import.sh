@@ -0,0 +1,2 @@
+# Workspaces can be imported using their id
+terraform import nftower_workspace.example 123456789
@@ -0,0 +1,2 @@
+# Workspace participants can be imported using workspace_id/participant_id
+terraform import nftower_workspace_participant.example 123456789/12345
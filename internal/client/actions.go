@@ -63,20 +63,38 @@ func (c *TowerClient) CreateAction(
 		return "", err
 	}
 
-	actionObj := res.(map[string]interface{})
-	return actionObj["actionId"].(string), nil
+	var actionObj struct {
+		ActionId string `json:"actionId"`
+	}
+
+	if err := decodeResponse(res, &actionObj); err != nil {
+		return "", err
+	}
+
+	return actionObj.ActionId, nil
 }
 
-func (c *TowerClient) GetAction(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+func (c *TowerClient) GetAction(ctx context.Context, workspaceId string, id string) (*Action, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/actions/%s", id), map[string]string{"workspaceId": workspaceId, "attributes": "labels"})
 
 	if err != nil {
 		return nil, err
 	}
 
-	actionObj := res.(map[string]interface{})
+	var actionObj struct {
+		Action Action `json:"action"`
+	}
+
+	if err := decodeResponse(res, &actionObj); err != nil {
+		return nil, err
+	}
+
+	return &actionObj.Action, nil
+}
 
-	return actionObj["action"].(map[string]interface{}), nil
+// ListActions returns the actions of a workspace, without their Launch.
+func (c *TowerClient) ListActions(ctx context.Context, workspaceId string) ([]Action, error) {
+	return listAll[Action](ctx, c, "/actions", map[string]string{"workspaceId": workspaceId}, "actions", pageSize)
 }
 
 func (c *TowerClient) UpdateAction(
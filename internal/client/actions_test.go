@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestActions(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		check  func(t *testing.T, action *Action)
+	}{
+		{
+			name:   "tower",
+			source: "tower",
+			check: func(t *testing.T, action *Action) {
+				if action.HookUrl == "" {
+					t.Fatalf("expected a hook url, got %+v", *action)
+				}
+			},
+		},
+		{
+			name:   "github",
+			source: "github",
+			check: func(t *testing.T, action *Action) {
+				if action.HookUrl != "" {
+					t.Fatalf("expected no hook url, got %+v", *action)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := newRecordedClient(t)
+			workspaceId := createTestWorkspace(t, c)
+			computeEnvId := createTestComputeEnv(t, c, workspaceId)
+
+			id, err := c.CreateAction(
+				ctx, workspaceId, "tf-acceptance-action", tt.source, computeEnvId,
+				"https://github.com/nextflow-io/hello", "s3://tf-acceptance/work", "master",
+				"", "", []interface{}{}, "", "", "", "", "", "", []interface{}{},
+				[]string{"tf-acceptance"})
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			action, err := c.GetAction(ctx, workspaceId, id)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if action.Id != id || action.Name != "tf-acceptance-action" || action.Source != tt.source {
+				t.Fatalf("unexpected action: %+v", *action)
+			}
+
+			if action.Launch.ComputeEnvId() != computeEnvId || action.Launch.Revision != "master" {
+				t.Fatalf("unexpected launch: %+v", *action.Launch)
+			}
+
+			if names := labelNames(action.Labels); len(names) != 1 || names[0] != "tf-acceptance" {
+				t.Fatalf("expected the tf-acceptance label, got %v", names)
+			}
+
+			tt.check(t, action)
+
+			actions, err := c.ListActions(ctx, workspaceId)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if len(actions) != 1 || actions[0].Id != id {
+				t.Fatalf("expected action %s, got %+v", id, actions)
+			}
+
+			err = c.UpdateAction(
+				ctx, workspaceId, id, "https://github.com/nextflow-io/hello", action.Launch.Id, computeEnvId,
+				"s3://tf-acceptance/work", "main", "", "echo post", []interface{}{}, "", "", "", "", "", "", []interface{}{},
+				[]string{})
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			action, err = c.GetAction(ctx, workspaceId, id)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if action.Launch.Revision != "main" || action.Launch.PostRunScript != "echo post" || len(action.Labels) != 0 {
+				t.Fatalf("action was not updated: %+v %+v", *action, *action.Launch)
+			}
+
+			if err := c.DeleteAction(ctx, workspaceId, id); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// listCache holds the pages returned by list endpoints for ttl, so that the
+// name lookups every resource makes during a terraform run do not list the
+// same objects over and over. Pages are keyed by the workspace and endpoint
+// they were listed from, and a write to an endpoint drops every page cached
+// for it in the same workspace.
+type listCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[listCacheKey]map[string]listCacheEntry
+	// generation is bumped by every invalidation, so that a page fetched
+	// while a write was in flight is not cached
+	generation uint64
+}
+
+type listCacheKey struct {
+	workspace string
+	endpoint  string
+}
+
+type listCacheEntry struct {
+	page    interface{}
+	expires time.Time
+}
+
+func newListCache(ttl time.Duration) *listCache {
+	return &listCache{
+		ttl:     ttl,
+		entries: map[listCacheKey]map[string]listCacheEntry{},
+	}
+}
+
+// get returns the page cached for the request, along with the generation to
+// give back to put once the page has been fetched otherwise.
+func (lc *listCache) get(path string, query map[string]string) (interface{}, uint64, bool) {
+	key, request := listCacheKeyOf(path, query)
+
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	entry, ok := lc.entries[key][request]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, lc.generation, false
+	}
+
+	return entry.page, lc.generation, true
+}
+
+// put caches the page of a request, unless the cache was invalidated since
+// generation was returned by get.
+func (lc *listCache) put(path string, query map[string]string, page interface{}, generation uint64) {
+	key, request := listCacheKeyOf(path, query)
+
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if generation != lc.generation {
+		return
+	}
+
+	if lc.entries[key] == nil {
+		lc.entries[key] = map[string]listCacheEntry{}
+	}
+
+	lc.entries[key][request] = listCacheEntry{
+		page:    page,
+		expires: time.Now().Add(lc.ttl),
+	}
+}
+
+// invalidate drops the pages cached for the endpoint and workspace a write
+// request was sent to.
+func (lc *listCache) invalidate(path string, query map[string]string) {
+	key, _ := listCacheKeyOf(path, query)
+
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	lc.generation++
+	delete(lc.entries, key)
+}
+
+// listCacheKeyOf returns the workspace and endpoint of a request, along with
+// a string identifying the request itself within them. The endpoint is the
+// first path segment past the organization and workspace the request is
+// scoped to, so that /compute-envs/1/primary and /compute-envs share the
+// compute-envs endpoint, and /orgs/1/workspaces/2/participants/add and
+// /orgs/1/workspaces/2/participants share the participants endpoint of
+// workspace 2.
+func listCacheKeyOf(path string, query map[string]string) (listCacheKey, string) {
+	key := listCacheKey{workspace: query["workspaceId"]}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for len(segments) > 2 && (segments[0] == "orgs" || segments[0] == "workspaces") {
+		if segments[0] == "workspaces" {
+			key.workspace = segments[1]
+		}
+		segments = segments[2:]
+	}
+	key.endpoint = segments[0]
+
+	params := []string{}
+	for k, v := range query {
+		params = append(params, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(params)
+
+	return key, path + "?" + strings.Join(params, "&")
+}
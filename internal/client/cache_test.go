@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestListCacheKeyOf(t *testing.T) {
+	tests := []struct {
+		path      string
+		query     map[string]string
+		workspace string
+		endpoint  string
+	}{
+		{path: "/compute-envs", query: map[string]string{"workspaceId": "1"}, workspace: "1", endpoint: "compute-envs"},
+		{path: "/compute-envs/abc/primary", query: map[string]string{"workspaceId": "1"}, workspace: "1", endpoint: "compute-envs"},
+		{path: "/tokens", endpoint: "tokens"},
+		{path: "/tokens/12", endpoint: "tokens"},
+		{path: "/orgs/1/workspaces", endpoint: "workspaces"},
+		{path: "/orgs/1/workspaces/2", endpoint: "workspaces"},
+		{path: "/orgs/1/members/add", endpoint: "members"},
+		{path: "/orgs/1/workspaces/2/participants", workspace: "2", endpoint: "participants"},
+		{path: "/orgs/1/workspaces/2/participants/3/role", workspace: "2", endpoint: "participants"},
+		{path: "/workspaces/2/datasets", workspace: "2", endpoint: "datasets"},
+		{path: "/workspaces/2/datasets/abc/versions", workspace: "2", endpoint: "datasets"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			key, _ := listCacheKeyOf(tt.path, tt.query)
+
+			if key.workspace != tt.workspace || key.endpoint != tt.endpoint {
+				t.Fatalf("expected workspace %q and endpoint %q, got %q and %q", tt.workspace, tt.endpoint, key.workspace, key.endpoint)
+			}
+		})
+	}
+}
+
+func TestListCache(t *testing.T) {
+	var mu sync.Mutex
+	lists := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/orgs":
+			w.Write([]byte(`{"organizations": [{"orgId": 1, "name": "tf-acceptance"}]}`))
+		case r.Method == "GET":
+			mu.Lock()
+			lists[r.URL.Path+"?"+r.URL.Query().Get("workspaceId")]++
+			mu.Unlock()
+
+			w.Write([]byte(`{"computeEnvs": [], "credentials": [], "totalSize": 0}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	newClient := func(t *testing.T, ttl time.Duration) *TowerClient {
+		opts := DefaultOptions()
+		opts.ListCacheTTL = ttl
+
+		c, err := NewTowerClientWithOptions(context.Background(), "nftower-client-tests", "test", server.URL, "tf-acceptance", opts)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		return c
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		run      func(t *testing.T, c *TowerClient)
+		expected map[string]int
+	}{
+		{
+			name: "cached",
+			ttl:  time.Minute,
+			run: func(t *testing.T, c *TowerClient) {
+				c.ListComputeEnvs(ctx, "1")
+				c.ListComputeEnvs(ctx, "1")
+				c.GetComputeEnvByName(ctx, "1", "unknown")
+			},
+			expected: map[string]int{"/compute-envs?1": 1},
+		},
+		{
+			name: "disabled",
+			ttl:  0,
+			run: func(t *testing.T, c *TowerClient) {
+				c.ListComputeEnvs(ctx, "1")
+				c.ListComputeEnvs(ctx, "1")
+			},
+			expected: map[string]int{"/compute-envs?1": 2},
+		},
+		{
+			name: "expired",
+			ttl:  10 * time.Millisecond,
+			run: func(t *testing.T, c *TowerClient) {
+				c.ListComputeEnvs(ctx, "1")
+				time.Sleep(20 * time.Millisecond)
+				c.ListComputeEnvs(ctx, "1")
+			},
+			expected: map[string]int{"/compute-envs?1": 2},
+		},
+		{
+			name: "keyed by workspace",
+			ttl:  time.Minute,
+			run: func(t *testing.T, c *TowerClient) {
+				c.ListComputeEnvs(ctx, "1")
+				c.ListComputeEnvs(ctx, "2")
+				c.ListCredentials(ctx, "1")
+				c.ListComputeEnvs(ctx, "1")
+			},
+			expected: map[string]int{"/compute-envs?1": 1, "/compute-envs?2": 1, "/credentials?1": 1},
+		},
+		{
+			name: "invalidated by writes",
+			ttl:  time.Minute,
+			run: func(t *testing.T, c *TowerClient) {
+				c.ListComputeEnvs(ctx, "1")
+				c.ListComputeEnvs(ctx, "2")
+				c.ListCredentials(ctx, "1")
+
+				if err := c.DeleteComputeEnv(ctx, "1", "abc"); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				c.ListComputeEnvs(ctx, "1")
+				c.ListComputeEnvs(ctx, "2")
+				c.ListCredentials(ctx, "1")
+			},
+			expected: map[string]int{"/compute-envs?1": 2, "/compute-envs?2": 1, "/credentials?1": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mu.Lock()
+			lists = map[string]int{}
+			mu.Unlock()
+
+			tt.run(t, newClient(t, tt.ttl))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for k, v := range tt.expected {
+				if lists[k] != v {
+					t.Fatalf("expected %d requests for %s, got %d", v, k, lists[k])
+				}
+			}
+			if len(lists) != len(tt.expected) {
+				t.Fatalf("expected requests for %v, got %v", tt.expected, lists)
+			}
+		})
+	}
+}
+
+func TestListCacheConcurrentWrite(t *testing.T) {
+	lc := newListCache(time.Minute)
+
+	// a page fetched while a write was in flight is not cached
+	_, generation, _ := lc.get("/pipelines", map[string]string{"workspaceId": "1"})
+	lc.invalidate("/pipelines/2", map[string]string{"workspaceId": "1"})
+	lc.put("/pipelines", map[string]string{"workspaceId": "1"}, map[string]interface{}{}, generation)
+
+	if _, _, ok := lc.get("/pipelines", map[string]string{"workspaceId": "1"}); ok {
+		t.Fatal("expected the page not to be cached")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/textproto"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/hashicorp/go-retryablehttp"
@@ -23,13 +24,67 @@ type TowerClient struct {
 	apiUrl    *url.URL
 	orgId     int64
 	http      *retryablehttp.Client
+	cache     *listCache
+}
+
+// Options tune how the client retries and paces its requests to tower.
+type Options struct {
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the wait between two attempts,
+	// unless tower asks for a longer one with a Retry-After header.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// RequestTimeout bounds each attempt of a request. Zero means no timeout.
+	RequestTimeout time.Duration
+	// RequestsPerSecond and Burst configure the rate limiter shared by every
+	// request of the client. A RequestsPerSecond of zero disables it.
+	RequestsPerSecond float64
+	Burst             int
+	// ListCacheTTL is how long the pages returned by list endpoints are
+	// cached for. Zero disables the cache.
+	ListCacheTTL time.Duration
+}
+
+// DefaultOptions returns the options used by NewTowerClient.
+func DefaultOptions() Options {
+	return Options{
+		MaxRetries:        4,
+		RetryWaitMin:      1 * time.Second,
+		RetryWaitMax:      30 * time.Second,
+		RequestTimeout:    60 * time.Second,
+		RequestsPerSecond: 20,
+		Burst:             20,
+		ListCacheTTL:      60 * time.Second,
+	}
 }
 
 func NewTowerClient(ctx context.Context, userAgent string, apiKey string, apiUrl string, org string) (*TowerClient, error) {
+	return NewTowerClientWithOptions(ctx, userAgent, apiKey, apiUrl, org, DefaultOptions())
+}
+
+func NewTowerClientWithOptions(ctx context.Context, userAgent string, apiKey string, apiUrl string, org string, opts Options) (*TowerClient, error) {
 	u, _ := url.Parse(apiUrl)
 
 	httpClient := retryablehttp.NewClient()
 	httpClient.Logger = nil
+	httpClient.RetryMax = opts.MaxRetries
+	httpClient.RetryWaitMin = opts.RetryWaitMin
+	httpClient.RetryWaitMax = opts.RetryWaitMax
+	httpClient.CheckRetry = checkRetry
+	httpClient.Backoff = backoff
+	// return the last response once retries are exhausted, so that it is
+	// reported as a TowerError
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.HTTPClient.Timeout = opts.RequestTimeout
+
+	if opts.RequestsPerSecond > 0 {
+		httpClient.HTTPClient.Transport = &rateLimitedTransport{
+			limiter: newRateLimiter(opts.RequestsPerSecond, opts.Burst),
+			base:    httpClient.HTTPClient.Transport,
+		}
+	}
+
 	httpClient.RequestLogHook = (func(_ retryablehttp.Logger, req *http.Request, attempt int) {
 		var body string = formatRequestBody(req)
 		tflog.Trace(
@@ -57,6 +112,10 @@ func NewTowerClient(ctx context.Context, userAgent string, apiKey string, apiUrl
 		http:      httpClient,
 	}
 
+	if opts.ListCacheTTL > 0 {
+		c.cache = newListCache(opts.ListCacheTTL)
+	}
+
 	orgId, err := c.getOrgIdFromName(ctx, org)
 
 	if err != nil {
@@ -68,21 +127,20 @@ func NewTowerClient(ctx context.Context, userAgent string, apiKey string, apiUrl
 	return c, nil
 }
 
+var errEmptyResponse = fmt.Errorf("Empty response from server")
+
 func (c *TowerClient) getOrgIdFromName(ctx context.Context, orgName string) (int64, error) {
 	tflog.Trace(ctx, fmt.Sprintf("Getting orgId from name for %s", orgName))
-	res, err := c.requestWithoutPayload(ctx, "GET", "/orgs", nil)
+	it := newPageIterator[Organization](c, "/orgs", nil, "organizations", pageSize)
 
-	if err != nil {
-		return -1, err
+	for it.Next(ctx) {
+		if o := it.Item(); o.Name == orgName {
+			return o.OrgId, nil
+		}
 	}
 
-	if orgs, ok := res.(map[string]interface{}); ok {
-		for _, org := range orgs["organizations"].([]interface{}) {
-			o, _ := org.(map[string]interface{})
-			if o["name"].(string) == orgName {
-				return int64(o["orgId"].(float64)), nil
-			}
-		}
+	if err := it.Err(); err != nil {
+		return -1, err
 	}
 
 	return -1, fmt.Errorf("Could not find an organization with the name %s", orgName)
@@ -148,6 +206,11 @@ func (c *TowerClient) requestWithPayload(ctx context.Context, method string, pat
 }
 
 func (c *TowerClient) request(ctx context.Context, method string, path string, query map[string]string, payload io.Reader, contentType string) (interface{}, error) {
+	if c.cache != nil && method != "GET" {
+		// the pages cached for the endpoint are dropped once the write is
+		// done, whether it succeeded or not
+		defer c.cache.invalidate(path, query)
+	}
 
 	var querystring string = ""
 	if query != nil {
@@ -159,7 +222,7 @@ func (c *TowerClient) request(ctx context.Context, method string, path string, q
 	}
 
 	req, err := retryablehttp.NewRequestWithContext(
-		ctx,
+		withRequestMethod(ctx, method),
 		method,
 		c.apiUrl.ResolveReference(&url.URL{Path: c.apiUrl.JoinPath(path).Path, RawQuery: querystring}).String(),
 		payload)
@@ -189,7 +252,7 @@ func (c *TowerClient) request(ctx context.Context, method string, path string, q
 	}
 
 	if httpResp.StatusCode > 399 {
-		return nil, newTowerError(fmt.Errorf("Tower API returned status: %s %s %s", httpResp.Status, httpResp.Request.URL, string(body)), httpResp.StatusCode)
+		return nil, newTowerError(httpResp, body)
 	}
 
 	if body == nil || len(body) == 0 {
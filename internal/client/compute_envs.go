@@ -24,6 +24,10 @@ type ComputeEnvAWSBatchConfig struct {
 	HeadJobRole    string `json:"headJobRole,omitempty"`
 	ComputeJobRole string `json:"computeJobRole,omitempty"`
 
+	Forge          *ComputeEnvAWSBatchForgeConfig `json:"forge,omitempty"`
+	Fusion2Enabled bool                           `json:"fusion2Enabled"`
+	WaveEnabled    bool                           `json:"waveEnabled"`
+
 	PreRunScript    string                    `json:"preRunScript,omitempty"`
 	PostRunScript   string                    `json:"postRunScript,omitempty"`
 	HeadJobCpus     int                       `json:"headJobCpus"`
@@ -31,26 +35,132 @@ type ComputeEnvAWSBatchConfig struct {
 	Environment     []*ComputeEnvConfigEnvVar `json:"environment,omitempty"`
 }
 
-type ComputeEnvLSFPlatformConfig struct {
+type ComputeEnvAWSBatchForgeConfig struct {
+	Type              string `json:"type"`
+	MinCpus           int    `json:"minCpus"`
+	MaxCpus           int    `json:"maxCpus"`
+	GpuEnabled        bool   `json:"gpuEnabled"`
+	EbsAutoScale      bool   `json:"ebsAutoScale"`
+	EfsCreate         bool   `json:"efsCreate"`
+	DisposeOnDeletion bool   `json:"disposeOnDeletion"`
+
+	DesiredCpus    int      `json:"desiredCpus,omitempty"`
+	InstanceTypes  []string `json:"instanceTypes,omitempty"`
+	VpcId          string   `json:"vpcId,omitempty"`
+	Subnets        []string `json:"subnets,omitempty"`
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	EbsBlockSize   int      `json:"ebsBlockSize,omitempty"`
+	FsxName        string   `json:"fsxName,omitempty"`
+	FsxMount       string   `json:"fsxMount,omitempty"`
+	FsxSize        int      `json:"fsxSize,omitempty"`
+	EfsId          string   `json:"efsId,omitempty"`
+	EfsMount       string   `json:"efsMount,omitempty"`
+	AllowBuckets   []string `json:"allowBuckets,omitempty"`
+	Ec2KeyPair     string   `json:"ec2KeyPair,omitempty"`
+}
+
+// ComputeEnvHPCPlatformConfig holds the settings shared by the SSH based HPC
+// scheduler platforms (LSF, Slurm, Altair PBS Pro, Grid Engine and Moab).
+type ComputeEnvHPCPlatformConfig struct {
 	WorkDir                 string `json:"workDir"`
 	LaunchDir               string `json:"launchDir"`
-	UserName                string `json:"userName"`
-	HostName                string `json:"hostName"`
+	UserName                string `json:"userName,omitempty"`
+	HostName                string `json:"hostName,omitempty"`
 	HeadQueue               string `json:"headQueue"`
 	ComputeQueue            string `json:"computeQueue"`
 	HeadJobOptions          string `json:"headJobOptions"`
 	PropagateHeadJobOptions bool   `json:"propagateHeadJobOptions"`
-	PerJobMemLimit          bool   `json:"perJobMemLimit"`
-	PerTaskReserve          bool   `json:"perTaskReserve"`
 
 	Port          int                       `json:"port,omitempty"`
 	MaxQueueSize  int                       `json:"maxQueueSize,omitempty"`
 	PreRunScript  string                    `json:"preRunScript,omitempty"`
 	PostRunScript string                    `json:"postRunScript,omitempty"`
-	UnitForLimits string                    `json:"unitForLimits,omitempty"`
 	Environment   []*ComputeEnvConfigEnvVar `json:"environment,omitempty"`
 }
 
+type ComputeEnvLSFPlatformConfig struct {
+	ComputeEnvHPCPlatformConfig
+
+	PerJobMemLimit bool   `json:"perJobMemLimit"`
+	PerTaskReserve bool   `json:"perTaskReserve"`
+	UnitForLimits  string `json:"unitForLimits,omitempty"`
+}
+
+type ComputeEnvGoogleBatchConfig struct {
+	Location          string            `json:"location"`
+	WorkDir           string            `json:"workDir"`
+	Spot              bool              `json:"spot"`
+	UsePrivateAddress bool              `json:"usePrivateAddress"`
+	BootDiskSizeGb    int               `json:"bootDiskSizeGb,omitempty"`
+	CpuPlatform       string            `json:"cpuPlatform,omitempty"`
+	MachineType       string            `json:"machineType,omitempty"`
+	Network           string            `json:"network,omitempty"`
+	Subnetwork        string            `json:"subnetwork,omitempty"`
+	ServiceAccount    string            `json:"serviceAccount,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+
+	PreRunScript    string                    `json:"preRunScript,omitempty"`
+	PostRunScript   string                    `json:"postRunScript,omitempty"`
+	HeadJobCpus     int                       `json:"headJobCpus,omitempty"`
+	HeadJobMemoryMb int                       `json:"headJobMemoryMb,omitempty"`
+	Environment     []*ComputeEnvConfigEnvVar `json:"environment,omitempty"`
+}
+
+type ComputeEnvAzureBatchForgeConfig struct {
+	VmType            string   `json:"vmType"`
+	VmCount           int      `json:"vmCount"`
+	AutoScale         bool     `json:"autoScale"`
+	DisposeOnDeletion bool     `json:"disposeOnDeletion"`
+	ContainerRegIds   []string `json:"containerRegIds,omitempty"`
+}
+
+type ComputeEnvAzureBatchConfig struct {
+	Region                  string                           `json:"region"`
+	WorkDir                 string                           `json:"workDir"`
+	HeadPool                string                           `json:"headPool,omitempty"`
+	AutoPoolMode            bool                             `json:"autoPoolMode"`
+	Forge                   *ComputeEnvAzureBatchForgeConfig `json:"forge,omitempty"`
+	DeleteJobsOnCompletion  string                           `json:"deleteJobsOnCompletion,omitempty"`
+	DeletePoolsOnCompletion bool                             `json:"deletePoolsOnCompletion"`
+	TokenDuration           string                           `json:"tokenDuration,omitempty"`
+
+	PreRunScript  string                    `json:"preRunScript,omitempty"`
+	PostRunScript string                    `json:"postRunScript,omitempty"`
+	Environment   []*ComputeEnvConfigEnvVar `json:"environment,omitempty"`
+}
+
+type ComputeEnvK8sPlatformConfig struct {
+	WorkDir               string `json:"workDir"`
+	Server                string `json:"server,omitempty"`
+	SslCert               string `json:"sslCert,omitempty"`
+	Namespace             string `json:"namespace"`
+	HeadServiceAccount    string `json:"headServiceAccount,omitempty"`
+	ComputeServiceAccount string `json:"computeServiceAccount,omitempty"`
+	StorageClaimName      string `json:"storageClaimName"`
+	StorageMountPath      string `json:"storageMountPath,omitempty"`
+	PodCleanup            string `json:"podCleanup,omitempty"`
+	HeadPodSpec           string `json:"headPodSpec,omitempty"`
+	ServicePodSpec        string `json:"servicePodSpec,omitempty"`
+
+	PreRunScript  string                    `json:"preRunScript,omitempty"`
+	PostRunScript string                    `json:"postRunScript,omitempty"`
+	Environment   []*ComputeEnvConfigEnvVar `json:"environment,omitempty"`
+}
+
+type ComputeEnvEKSPlatformConfig struct {
+	ComputeEnvK8sPlatformConfig
+
+	Region      string `json:"region"`
+	ClusterName string `json:"clusterName"`
+}
+
+type ComputeEnvGKEPlatformConfig struct {
+	ComputeEnvK8sPlatformConfig
+
+	Region      string `json:"region"`
+	ClusterName string `json:"clusterName"`
+}
+
 func (c *TowerClient) CreateLSFPlatformComputeEnv(
 	ctx context.Context,
 	workspaceId string,
@@ -93,6 +203,136 @@ func (c *TowerClient) CreateAWSBatchComputeEnv(
 	return c.createComputeEnv(ctx, workspaceId, payload)
 }
 
+// CreateHPCPlatformComputeEnv creates a compute environment for one of the
+// HPC schedulers without platform specific settings e.g. slurm-platform,
+// altair-platform, uge-platform or moab-platform.
+func (c *TowerClient) CreateHPCPlatformComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	platform string,
+	name string,
+	description string,
+	credentialsId string,
+	config *ComputeEnvHPCPlatformConfig) (string, error) {
+
+	payload := map[string]interface{}{
+		"computeEnv": map[string]interface{}{
+			"name":          name,
+			"description":   description,
+			"platform":      platform,
+			"credentialsId": credentialsId,
+			"config":        marshalComputeEnvHPCPlatformConfig(config),
+		},
+	}
+
+	return c.createComputeEnv(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateGoogleBatchComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	credentialsId string,
+	config *ComputeEnvGoogleBatchConfig) (string, error) {
+
+	payload := map[string]interface{}{
+		"computeEnv": map[string]interface{}{
+			"name":          name,
+			"description":   description,
+			"platform":      "google-batch",
+			"credentialsId": credentialsId,
+			"config":        marshalComputeEnvGoogleBatchConfig(config),
+		},
+	}
+
+	return c.createComputeEnv(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateAzureBatchComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	credentialsId string,
+	config *ComputeEnvAzureBatchConfig) (string, error) {
+
+	payload := map[string]interface{}{
+		"computeEnv": map[string]interface{}{
+			"name":          name,
+			"description":   description,
+			"platform":      "azure-batch",
+			"credentialsId": credentialsId,
+			"config":        marshalComputeEnvAzureBatchConfig(config),
+		},
+	}
+
+	return c.createComputeEnv(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateK8sPlatformComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	credentialsId string,
+	config *ComputeEnvK8sPlatformConfig) (string, error) {
+
+	payload := map[string]interface{}{
+		"computeEnv": map[string]interface{}{
+			"name":          name,
+			"description":   description,
+			"platform":      "k8s-platform",
+			"credentialsId": credentialsId,
+			"config":        marshalComputeEnvK8sPlatformConfig(config),
+		},
+	}
+
+	return c.createComputeEnv(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateEKSPlatformComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	credentialsId string,
+	config *ComputeEnvEKSPlatformConfig) (string, error) {
+
+	payload := map[string]interface{}{
+		"computeEnv": map[string]interface{}{
+			"name":          name,
+			"description":   description,
+			"platform":      "eks-platform",
+			"credentialsId": credentialsId,
+			"config":        marshalComputeEnvEKSPlatformConfig(config),
+		},
+	}
+
+	return c.createComputeEnv(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateGKEPlatformComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	credentialsId string,
+	config *ComputeEnvGKEPlatformConfig) (string, error) {
+
+	payload := map[string]interface{}{
+		"computeEnv": map[string]interface{}{
+			"name":          name,
+			"description":   description,
+			"platform":      "gke-platform",
+			"credentialsId": credentialsId,
+			"config":        marshalComputeEnvGKEPlatformConfig(config),
+		},
+	}
+
+	return c.createComputeEnv(ctx, workspaceId, payload)
+}
+
 func (c *TowerClient) createComputeEnv(ctx context.Context, workspaceId string, payload map[string]interface{}) (string, error) {
 	res, err := c.requestWithJsonPayload(ctx, "POST", "/compute-envs", map[string]string{"workspaceId": workspaceId}, payload)
 
@@ -100,68 +340,181 @@ func (c *TowerClient) createComputeEnv(ctx context.Context, workspaceId string,
 		return "", err
 	}
 
-	if res == nil {
-		return "", fmt.Errorf("Empty response from server")
+	var computeEnv struct {
+		ComputeEnvId string `json:"computeEnvId"`
 	}
 
-	computeEnv := res.(map[string]interface{})
+	if err := decodeResponse(res, &computeEnv); err != nil {
+		return "", err
+	}
 
-	return computeEnv["computeEnvId"].(string), nil
+	return computeEnv.ComputeEnvId, nil
 }
 
-func (c *TowerClient) GetComputeEnv(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+func (c *TowerClient) GetComputeEnv(ctx context.Context, workspaceId string, id string) (*ComputeEnv, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/compute-envs/%s", id), map[string]string{"workspaceId": workspaceId})
 
 	if err != nil {
 		return nil, err
 	}
 
-	computeEnvObj := res.(map[string]interface{})
-	computeEnv := computeEnvObj["computeEnv"].(map[string]interface{})
+	var computeEnvObj struct {
+		ComputeEnv ComputeEnv `json:"computeEnv"`
+	}
 
-	if v, ok := computeEnv["deleted"].(bool); ok {
-		if v {
-			return nil, nil
-		}
+	if err := decodeResponse(res, &computeEnvObj); err != nil {
+		return nil, err
 	}
 
-	switch computeEnv["platform"].(string) {
+	computeEnv := &computeEnvObj.ComputeEnv
+
+	if computeEnv.Deleted {
+		return nil, nil
+	}
+
+	switch computeEnv.Platform {
 	case "aws-batch":
-		config, err := unmarshalComputeEnvAWSBatchConfig(computeEnv["config"].(map[string]interface{}))
+		config, err := unmarshalComputeEnvAWSBatchConfig(computeEnv.RawConfig)
 		if err != nil {
 			return nil, err
 		}
-		computeEnv["config"] = *config
+		computeEnv.Config = *config
 	case "lsf-platform":
-		config, err := unmarshalComputeEnvLSFPlatformConfig(computeEnv["config"].(map[string]interface{}))
+		config, err := unmarshalComputeEnvLSFPlatformConfig(computeEnv.RawConfig)
+		if err != nil {
+			return nil, err
+		}
+		computeEnv.Config = *config
+	case "slurm-platform", "altair-platform", "uge-platform", "moab-platform":
+		config, err := unmarshalComputeEnvHPCPlatformConfig(computeEnv.RawConfig)
+		if err != nil {
+			return nil, err
+		}
+		computeEnv.Config = *config
+	case "google-batch":
+		config, err := unmarshalComputeEnvGoogleBatchConfig(computeEnv.RawConfig)
+		if err != nil {
+			return nil, err
+		}
+		computeEnv.Config = *config
+	case "azure-batch":
+		config, err := unmarshalComputeEnvAzureBatchConfig(computeEnv.RawConfig)
+		if err != nil {
+			return nil, err
+		}
+		computeEnv.Config = *config
+	case "k8s-platform":
+		config, err := unmarshalComputeEnvK8sPlatformConfig(computeEnv.RawConfig)
+		if err != nil {
+			return nil, err
+		}
+		computeEnv.Config = *config
+	case "eks-platform":
+		config, err := unmarshalComputeEnvEKSPlatformConfig(computeEnv.RawConfig)
+		if err != nil {
+			return nil, err
+		}
+		computeEnv.Config = *config
+	case "gke-platform":
+		config, err := unmarshalComputeEnvGKEPlatformConfig(computeEnv.RawConfig)
 		if err != nil {
 			return nil, err
 		}
-		computeEnv["config"] = *config
+		computeEnv.Config = *config
 	default:
-		return nil, fmt.Errorf("unsupported platform: %s", computeEnv["platform"])
+		return nil, fmt.Errorf("unsupported platform: %s", computeEnv.Platform)
 	}
 
 	return computeEnv, nil
 }
 
-func (c *TowerClient) GetComputeEnvByName(ctx context.Context, workspaceId string, name string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", "/compute-envs", map[string]string{"workspaceId": workspaceId})
+// ListComputeEnvs returns the compute environments of a workspace. Tower only
+// returns a summary of each, so their Config is not set.
+func (c *TowerClient) ListComputeEnvs(ctx context.Context, workspaceId string) ([]ComputeEnv, error) {
+	return listAll[ComputeEnv](ctx, c, "/compute-envs", map[string]string{"workspaceId": workspaceId}, "computeEnvs", pageSize)
+}
+
+func (c *TowerClient) GetComputeEnvByName(ctx context.Context, workspaceId string, name string) (*ComputeEnv, error) {
+	it := newPageIterator[ComputeEnv](c, "/compute-envs", map[string]string{"workspaceId": workspaceId}, "computeEnvs", pageSize)
+
+	for it.Next(ctx) {
+		if o := it.Item(); o.Name == name {
+			return c.GetComputeEnv(ctx, workspaceId, o.Id)
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("Could not find a computeEnv with the name '%s'", name)
+}
+
+// GetPrimaryComputeEnv returns the primary compute environment of the
+// workspace, or nil when the workspace has none.
+func (c *TowerClient) GetPrimaryComputeEnv(ctx context.Context, workspaceId string) (*ComputeEnv, error) {
+	res, err := c.requestWithoutPayload(ctx, "GET", "/compute-envs/primary", map[string]string{"workspaceId": workspaceId})
 
 	if err != nil {
 		return nil, err
 	}
 
-	if computeEnvs, ok := res.(map[string]interface{}); ok {
-		for _, computeEnv := range computeEnvs["computeEnvs"].([]interface{}) {
-			o, _ := computeEnv.(map[string]interface{})
-			if o["name"].(string) == name {
-				return c.GetComputeEnv(ctx, workspaceId, o["id"].(string))
-			}
-		}
+	if _, ok := res.(map[string]interface{}); !ok {
+		return nil, nil
 	}
 
-	return nil, fmt.Errorf("Could not find a computeEnv with the name '%s'", name)
+	var computeEnvObj struct {
+		ComputeEnv *ComputeEnv `json:"computeEnv"`
+	}
+
+	if err := decodeResponse(res, &computeEnvObj); err != nil {
+		return nil, err
+	}
+
+	if computeEnvObj.ComputeEnv == nil {
+		return nil, nil
+	}
+
+	return c.GetComputeEnv(ctx, workspaceId, computeEnvObj.ComputeEnv.Id)
+}
+
+func (c *TowerClient) SetPrimaryComputeEnv(ctx context.Context, workspaceId string, id string) error {
+	_, err := c.requestWithJsonPayload(ctx, "POST", fmt.Sprintf("/compute-envs/%s/primary", id), map[string]string{"workspaceId": workspaceId}, map[string]interface{}{})
+	return err
+}
+
+// UpdateComputeEnv updates the settings tower lets you edit on an existing
+// compute environment: its name, description and credentials, the scripts
+// run around nextflow and the environment variables. Tower keeps the rest of
+// the config, changing it requires a new compute environment.
+func (c *TowerClient) UpdateComputeEnv(
+	ctx context.Context,
+	workspaceId string,
+	id string,
+	name string,
+	description string,
+	credentialsId string,
+	preRunScript string,
+	postRunScript string,
+	environment []*ComputeEnvConfigEnvVar) error {
+
+	if environment == nil {
+		environment = []*ComputeEnvConfigEnvVar{}
+	}
+
+	payload := map[string]interface{}{
+		"name":          name,
+		"description":   description,
+		"credentialsId": credentialsId,
+		"config": map[string]interface{}{
+			"preRunScript":  preRunScript,
+			"postRunScript": postRunScript,
+			"environment":   environment,
+		},
+	}
+
+	_, err := c.requestWithJsonPayload(ctx, "PUT", fmt.Sprintf("/compute-envs/%s", id), map[string]string{"workspaceId": workspaceId}, payload)
+	return err
 }
 
 func (c *TowerClient) DeleteComputeEnv(ctx context.Context, workspaceId string, id string) error {
@@ -209,6 +562,14 @@ func marshalComputeEnvAWSBatchConfig(config *ComputeEnvAWSBatchConfig) map[strin
 		payload["executionRole"] = config.ExecutionRole
 	}
 
+	if config.PreRunScript != "" {
+		payload["preRunScript"] = config.PreRunScript
+	}
+
+	if config.PostRunScript != "" {
+		payload["postRunScript"] = config.PostRunScript
+	}
+
 	if config.HeadJobCpus != 0 {
 		payload["headJobCpus"] = config.HeadJobCpus
 	}
@@ -217,6 +578,18 @@ func marshalComputeEnvAWSBatchConfig(config *ComputeEnvAWSBatchConfig) map[strin
 		payload["headJobMemoryMb"] = config.HeadJobMemoryMb
 	}
 
+	if config.Forge != nil {
+		payload["forge"] = config.Forge
+	}
+
+	if config.Fusion2Enabled {
+		payload["fusion2Enabled"] = config.Fusion2Enabled
+	}
+
+	if config.WaveEnabled {
+		payload["waveEnabled"] = config.WaveEnabled
+	}
+
 	return payload
 }
 
@@ -239,18 +612,56 @@ func unmarshalComputeEnvLSFPlatformConfig(payload map[string]interface{}) (*Comp
 }
 
 func marshalComputeEnvLSFPlatformConfig(config *ComputeEnvLSFPlatformConfig) map[string]interface{} {
+	payload := marshalComputeEnvHPCPlatformConfig(&config.ComputeEnvHPCPlatformConfig)
+
+	payload["perJobMemLimit"] = config.PerJobMemLimit
+	payload["perTaskReserve"] = config.PerTaskReserve
+
+	// unitForLimits
+	if config.UnitForLimits != "" {
+		payload["unitForLimits"] = config.UnitForLimits
+	}
+
+	return payload
+}
+
+func unmarshalComputeEnvHPCPlatformConfig(payload map[string]interface{}) (*ComputeEnvHPCPlatformConfig, error) {
+	var output ComputeEnvHPCPlatformConfig
+
+	b, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func marshalComputeEnvHPCPlatformConfig(config *ComputeEnvHPCPlatformConfig) map[string]interface{} {
 	payload := map[string]interface{}{
 		"workDir":                 config.WorkDir,
 		"launchDir":               config.LaunchDir,
-		"userName":                config.UserName,
-		"hostName":                config.HostName,
 		"headQueue":               config.HeadQueue,
 		"computeQueue":            config.ComputeQueue,
 		"maxQueueSize":            config.MaxQueueSize,
 		"headJobOptions":          config.HeadJobOptions,
 		"propagateHeadJobOptions": config.PropagateHeadJobOptions,
-		"perJobMemLimit":          config.PerJobMemLimit,
-		"perTaskReserve":          config.PerTaskReserve,
+	}
+
+	// userName and hostName are only used with SSH credentials, Tower Agent
+	// credentials connect from the cluster itself
+	if config.UserName != "" {
+		payload["userName"] = config.UserName
+	}
+
+	if config.HostName != "" {
+		payload["hostName"] = config.HostName
 	}
 
 	// port
@@ -273,9 +684,93 @@ func marshalComputeEnvLSFPlatformConfig(config *ComputeEnvLSFPlatformConfig) map
 		payload["postRunScript"] = config.PostRunScript
 	}
 
-	// unitForLimits
-	if config.UnitForLimits != "" {
-		payload["unitForLimits"] = config.UnitForLimits
+	// environment
+	if len(config.Environment) > 0 {
+		payload["environment"] = config.Environment
+	}
+
+	return payload
+}
+
+func unmarshalComputeEnvGoogleBatchConfig(payload map[string]interface{}) (*ComputeEnvGoogleBatchConfig, error) {
+	var output ComputeEnvGoogleBatchConfig
+
+	b, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func marshalComputeEnvGoogleBatchConfig(config *ComputeEnvGoogleBatchConfig) map[string]interface{} {
+	payload := map[string]interface{}{
+		"location":          config.Location,
+		"workDir":           config.WorkDir,
+		"spot":              config.Spot,
+		"usePrivateAddress": config.UsePrivateAddress,
+	}
+
+	// bootDiskSizeGb
+	if config.BootDiskSizeGb != 0 {
+		payload["bootDiskSizeGb"] = config.BootDiskSizeGb
+	}
+
+	// cpuPlatform
+	if config.CpuPlatform != "" {
+		payload["cpuPlatform"] = config.CpuPlatform
+	}
+
+	// machineType
+	if config.MachineType != "" {
+		payload["machineType"] = config.MachineType
+	}
+
+	// network
+	if config.Network != "" {
+		payload["network"] = config.Network
+	}
+
+	// subnetwork
+	if config.Subnetwork != "" {
+		payload["subnetwork"] = config.Subnetwork
+	}
+
+	// serviceAccount
+	if config.ServiceAccount != "" {
+		payload["serviceAccount"] = config.ServiceAccount
+	}
+
+	// labels
+	if len(config.Labels) > 0 {
+		payload["labels"] = config.Labels
+	}
+
+	// preRunScript
+	if config.PreRunScript != "" {
+		payload["preRunScript"] = config.PreRunScript
+	}
+
+	// postRunScript
+	if config.PostRunScript != "" {
+		payload["postRunScript"] = config.PostRunScript
+	}
+
+	// headJobCpus
+	if config.HeadJobCpus != 0 {
+		payload["headJobCpus"] = config.HeadJobCpus
+	}
+
+	// headJobMemoryMb
+	if config.HeadJobMemoryMb != 0 {
+		payload["headJobMemoryMb"] = config.HeadJobMemoryMb
 	}
 
 	// environment
@@ -285,3 +780,215 @@ func marshalComputeEnvLSFPlatformConfig(config *ComputeEnvLSFPlatformConfig) map
 
 	return payload
 }
+
+func unmarshalComputeEnvAzureBatchConfig(payload map[string]interface{}) (*ComputeEnvAzureBatchConfig, error) {
+	var output ComputeEnvAzureBatchConfig
+
+	b, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func marshalComputeEnvAzureBatchConfig(config *ComputeEnvAzureBatchConfig) map[string]interface{} {
+	payload := map[string]interface{}{
+		"region":                  config.Region,
+		"workDir":                 config.WorkDir,
+		"autoPoolMode":            config.AutoPoolMode,
+		"deletePoolsOnCompletion": config.DeletePoolsOnCompletion,
+	}
+
+	// headPool
+	if config.HeadPool != "" {
+		payload["headPool"] = config.HeadPool
+	}
+
+	// forge
+	if config.Forge != nil {
+		forge := map[string]interface{}{
+			"vmType":            config.Forge.VmType,
+			"vmCount":           config.Forge.VmCount,
+			"autoScale":         config.Forge.AutoScale,
+			"disposeOnDeletion": config.Forge.DisposeOnDeletion,
+		}
+
+		if len(config.Forge.ContainerRegIds) > 0 {
+			forge["containerRegIds"] = config.Forge.ContainerRegIds
+		}
+
+		payload["forge"] = forge
+	}
+
+	// deleteJobsOnCompletion
+	if config.DeleteJobsOnCompletion != "" {
+		payload["deleteJobsOnCompletion"] = config.DeleteJobsOnCompletion
+	}
+
+	// tokenDuration
+	if config.TokenDuration != "" {
+		payload["tokenDuration"] = config.TokenDuration
+	}
+
+	// preRunScript
+	if config.PreRunScript != "" {
+		payload["preRunScript"] = config.PreRunScript
+	}
+
+	// postRunScript
+	if config.PostRunScript != "" {
+		payload["postRunScript"] = config.PostRunScript
+	}
+
+	// environment
+	if len(config.Environment) > 0 {
+		payload["environment"] = config.Environment
+	}
+
+	return payload
+}
+
+func unmarshalComputeEnvK8sPlatformConfig(payload map[string]interface{}) (*ComputeEnvK8sPlatformConfig, error) {
+	var output ComputeEnvK8sPlatformConfig
+
+	b, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func marshalComputeEnvK8sPlatformConfig(config *ComputeEnvK8sPlatformConfig) map[string]interface{} {
+	payload := map[string]interface{}{
+		"workDir":          config.WorkDir,
+		"namespace":        config.Namespace,
+		"storageClaimName": config.StorageClaimName,
+	}
+
+	// server
+	if config.Server != "" {
+		payload["server"] = config.Server
+	}
+
+	// sslCert
+	if config.SslCert != "" {
+		payload["sslCert"] = config.SslCert
+	}
+
+	// headServiceAccount
+	if config.HeadServiceAccount != "" {
+		payload["headServiceAccount"] = config.HeadServiceAccount
+	}
+
+	// computeServiceAccount
+	if config.ComputeServiceAccount != "" {
+		payload["computeServiceAccount"] = config.ComputeServiceAccount
+	}
+
+	// storageMountPath
+	if config.StorageMountPath != "" {
+		payload["storageMountPath"] = config.StorageMountPath
+	}
+
+	// podCleanup
+	if config.PodCleanup != "" {
+		payload["podCleanup"] = config.PodCleanup
+	}
+
+	// headPodSpec
+	if config.HeadPodSpec != "" {
+		payload["headPodSpec"] = config.HeadPodSpec
+	}
+
+	// servicePodSpec
+	if config.ServicePodSpec != "" {
+		payload["servicePodSpec"] = config.ServicePodSpec
+	}
+
+	// preRunScript
+	if config.PreRunScript != "" {
+		payload["preRunScript"] = config.PreRunScript
+	}
+
+	// postRunScript
+	if config.PostRunScript != "" {
+		payload["postRunScript"] = config.PostRunScript
+	}
+
+	// environment
+	if len(config.Environment) > 0 {
+		payload["environment"] = config.Environment
+	}
+
+	return payload
+}
+
+func unmarshalComputeEnvEKSPlatformConfig(payload map[string]interface{}) (*ComputeEnvEKSPlatformConfig, error) {
+	var output ComputeEnvEKSPlatformConfig
+
+	b, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func marshalComputeEnvEKSPlatformConfig(config *ComputeEnvEKSPlatformConfig) map[string]interface{} {
+	payload := marshalComputeEnvK8sPlatformConfig(&config.ComputeEnvK8sPlatformConfig)
+
+	payload["region"] = config.Region
+	payload["clusterName"] = config.ClusterName
+
+	return payload
+}
+
+func unmarshalComputeEnvGKEPlatformConfig(payload map[string]interface{}) (*ComputeEnvGKEPlatformConfig, error) {
+	var output ComputeEnvGKEPlatformConfig
+
+	b, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func marshalComputeEnvGKEPlatformConfig(config *ComputeEnvGKEPlatformConfig) map[string]interface{} {
+	payload := marshalComputeEnvK8sPlatformConfig(&config.ComputeEnvK8sPlatformConfig)
+
+	payload["region"] = config.Region
+	payload["clusterName"] = config.ClusterName
+
+	return payload
+}
@@ -0,0 +1,415 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// createTestComputeEnv creates an aws batch compute env in the workspace, for
+// the tests of objects which need one.
+func createTestComputeEnv(t *testing.T, c *TowerClient, workspaceId string) string {
+	t.Helper()
+
+	credentialsId := createTestCredentials(t, c, workspaceId)
+
+	id, err := c.CreateAWSBatchComputeEnv(context.Background(), workspaceId, "tf-acceptance-client-tests", "", credentialsId, &ComputeEnvAWSBatchConfig{
+		Region:       "eu-west-1",
+		ComputeQueue: "compute",
+		HeadQueue:    "head",
+		WorkDir:      "s3://tf-acceptance/work",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return id
+}
+
+func TestComputeEnvs(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform string
+		create   func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error)
+		expected interface{}
+	}{
+		{
+			name:     "aws batch",
+			platform: "aws-batch",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateAWSBatchComputeEnv(ctx, workspaceId, "tf-acceptance-aws-batch", "", credentialsId, &ComputeEnvAWSBatchConfig{
+					Region:          "eu-west-1",
+					ComputeQueue:    "compute",
+					HeadQueue:       "head",
+					CliPath:         "/home/ec2-user/miniconda/bin/aws",
+					WorkDir:         "s3://tf-acceptance/work",
+					HeadJobCpus:     2,
+					HeadJobMemoryMb: 4096,
+				})
+			},
+			expected: ComputeEnvAWSBatchConfig{
+				Region:          "eu-west-1",
+				ComputeQueue:    "compute",
+				HeadQueue:       "head",
+				CliPath:         "/home/ec2-user/miniconda/bin/aws",
+				WorkDir:         "s3://tf-acceptance/work",
+				HeadJobCpus:     2,
+				HeadJobMemoryMb: 4096,
+			},
+		},
+		{
+			name:     "lsf platform",
+			platform: "lsf-platform",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateLSFPlatformComputeEnv(ctx, workspaceId, "tf-acceptance-lsf", "", credentialsId, &ComputeEnvLSFPlatformConfig{
+					ComputeEnvHPCPlatformConfig: ComputeEnvHPCPlatformConfig{
+						WorkDir:      "/scratch/work",
+						LaunchDir:    "/scratch/launch",
+						UserName:     "tower",
+						HostName:     "lsf.example.com",
+						HeadQueue:    "head",
+						ComputeQueue: "compute",
+					},
+					PerJobMemLimit: true,
+					UnitForLimits:  "MB",
+				})
+			},
+			expected: ComputeEnvLSFPlatformConfig{
+				ComputeEnvHPCPlatformConfig: ComputeEnvHPCPlatformConfig{
+					WorkDir:      "/scratch/work",
+					LaunchDir:    "/scratch/launch",
+					UserName:     "tower",
+					HostName:     "lsf.example.com",
+					HeadQueue:    "head",
+					ComputeQueue: "compute",
+				},
+				PerJobMemLimit: true,
+				UnitForLimits:  "MB",
+			},
+		},
+		{
+			name:     "slurm platform",
+			platform: "slurm-platform",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateHPCPlatformComputeEnv(ctx, workspaceId, "slurm-platform", "tf-acceptance-slurm", "", credentialsId, &ComputeEnvHPCPlatformConfig{
+					WorkDir:      "/scratch/work",
+					LaunchDir:    "/scratch/launch",
+					UserName:     "tower",
+					HostName:     "slurm.example.com",
+					HeadQueue:    "head",
+					ComputeQueue: "compute",
+					Port:         2222,
+				})
+			},
+			expected: ComputeEnvHPCPlatformConfig{
+				WorkDir:      "/scratch/work",
+				LaunchDir:    "/scratch/launch",
+				UserName:     "tower",
+				HostName:     "slurm.example.com",
+				HeadQueue:    "head",
+				ComputeQueue: "compute",
+				Port:         2222,
+			},
+		},
+		{
+			name:     "google batch",
+			platform: "google-batch",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateGoogleBatchComputeEnv(ctx, workspaceId, "tf-acceptance-google-batch", "", credentialsId, &ComputeEnvGoogleBatchConfig{
+					Location: "europe-west2",
+					WorkDir:  "gs://tf-acceptance/work",
+					Spot:     true,
+					Labels:   map[string]string{"team": "tf-acceptance"},
+				})
+			},
+			expected: ComputeEnvGoogleBatchConfig{
+				Location: "europe-west2",
+				WorkDir:  "gs://tf-acceptance/work",
+				Spot:     true,
+				Labels:   map[string]string{"team": "tf-acceptance"},
+			},
+		},
+		{
+			name:     "azure batch",
+			platform: "azure-batch",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateAzureBatchComputeEnv(ctx, workspaceId, "tf-acceptance-azure-batch", "", credentialsId, &ComputeEnvAzureBatchConfig{
+					Region:  "uksouth",
+					WorkDir: "az://tf-acceptance/work",
+					Forge: &ComputeEnvAzureBatchForgeConfig{
+						VmType:  "Standard_D4_v3",
+						VmCount: 4,
+					},
+				})
+			},
+			expected: ComputeEnvAzureBatchConfig{
+				Region:  "uksouth",
+				WorkDir: "az://tf-acceptance/work",
+				Forge: &ComputeEnvAzureBatchForgeConfig{
+					VmType:  "Standard_D4_v3",
+					VmCount: 4,
+				},
+			},
+		},
+		{
+			name:     "k8s platform",
+			platform: "k8s-platform",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateK8sPlatformComputeEnv(ctx, workspaceId, "tf-acceptance-k8s", "", credentialsId, &ComputeEnvK8sPlatformConfig{
+					WorkDir:          "/scratch/work",
+					Server:           "https://k8s.example.com",
+					Namespace:        "tower",
+					StorageClaimName: "tower-scratch",
+				})
+			},
+			expected: ComputeEnvK8sPlatformConfig{
+				WorkDir:          "/scratch/work",
+				Server:           "https://k8s.example.com",
+				Namespace:        "tower",
+				StorageClaimName: "tower-scratch",
+			},
+		},
+		{
+			name:     "eks platform",
+			platform: "eks-platform",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateEKSPlatformComputeEnv(ctx, workspaceId, "tf-acceptance-eks", "", credentialsId, &ComputeEnvEKSPlatformConfig{
+					ComputeEnvK8sPlatformConfig: ComputeEnvK8sPlatformConfig{
+						WorkDir:          "/scratch/work",
+						Namespace:        "tower",
+						StorageClaimName: "tower-scratch",
+					},
+					Region:      "eu-west-1",
+					ClusterName: "tf-acceptance",
+				})
+			},
+			expected: ComputeEnvEKSPlatformConfig{
+				ComputeEnvK8sPlatformConfig: ComputeEnvK8sPlatformConfig{
+					WorkDir:          "/scratch/work",
+					Namespace:        "tower",
+					StorageClaimName: "tower-scratch",
+				},
+				Region:      "eu-west-1",
+				ClusterName: "tf-acceptance",
+			},
+		},
+		{
+			name:     "gke platform",
+			platform: "gke-platform",
+			create: func(ctx context.Context, c *TowerClient, workspaceId string, credentialsId string) (string, error) {
+				return c.CreateGKEPlatformComputeEnv(ctx, workspaceId, "tf-acceptance-gke", "", credentialsId, &ComputeEnvGKEPlatformConfig{
+					ComputeEnvK8sPlatformConfig: ComputeEnvK8sPlatformConfig{
+						WorkDir:          "/scratch/work",
+						Namespace:        "tower",
+						StorageClaimName: "tower-scratch",
+					},
+					Region:      "europe-west2",
+					ClusterName: "tf-acceptance",
+				})
+			},
+			expected: ComputeEnvGKEPlatformConfig{
+				ComputeEnvK8sPlatformConfig: ComputeEnvK8sPlatformConfig{
+					WorkDir:          "/scratch/work",
+					Namespace:        "tower",
+					StorageClaimName: "tower-scratch",
+				},
+				Region:      "europe-west2",
+				ClusterName: "tf-acceptance",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := newRecordedClient(t)
+			workspaceId := createTestWorkspace(t, c)
+			credentialsId := createTestCredentials(t, c, workspaceId)
+
+			id, err := tt.create(ctx, c, workspaceId, credentialsId)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			computeEnv, err := c.GetComputeEnv(ctx, workspaceId, id)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if computeEnv.Id != id || computeEnv.Platform != tt.platform || computeEnv.CredentialsId != credentialsId {
+				t.Fatalf("unexpected compute env: %+v", *computeEnv)
+			}
+
+			if !reflect.DeepEqual(computeEnv.Config, tt.expected) {
+				t.Fatalf("expected config %+v, got %+v", tt.expected, computeEnv.Config)
+			}
+
+			if err := c.DeleteComputeEnv(ctx, workspaceId, id); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			// tower flags deleted compute envs rather than removing them
+			computeEnv, err = c.GetComputeEnv(ctx, workspaceId, id)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if computeEnv != nil {
+				t.Fatalf("expected the deleted compute env to be nil, got %+v", *computeEnv)
+			}
+		})
+	}
+}
+
+func TestComputeEnvsLookups(t *testing.T) {
+	config := &ComputeEnvAWSBatchConfig{
+		Region:       "eu-west-1",
+		ComputeQueue: "compute",
+		HeadQueue:    "head",
+		WorkDir:      "s3://tf-acceptance/work",
+	}
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string)
+	}{
+		{
+			name: "by name",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateAWSBatchComputeEnv(ctx, workspaceId, "tf-acceptance-aws-batch", "", credentialsId, config)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				computeEnv, err := c.GetComputeEnvByName(ctx, workspaceId, "tf-acceptance-aws-batch")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if computeEnv.Id != id {
+					t.Fatalf("expected compute env %s, got %+v", id, *computeEnv)
+				}
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateAWSBatchComputeEnv(ctx, workspaceId, "tf-acceptance-aws-batch", "", credentialsId, config)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				computeEnvs, err := c.ListComputeEnvs(ctx, workspaceId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if len(computeEnvs) != 1 || computeEnvs[0].Id != id || computeEnvs[0].Platform != "aws-batch" {
+					t.Fatalf("expected compute env %s, got %+v", id, computeEnvs)
+				}
+			},
+		},
+		{
+			name: "unknown name",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string) {
+				computeEnv, err := c.GetComputeEnvByName(context.Background(), workspaceId, "tf-acceptance-unknown")
+
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", computeEnv)
+				}
+			},
+		},
+		{
+			name: "primary",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string) {
+				ctx := context.Background()
+
+				computeEnv, err := c.GetPrimaryComputeEnv(ctx, workspaceId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if computeEnv != nil {
+					t.Fatalf("expected no primary compute env, got %+v", *computeEnv)
+				}
+
+				id, err := c.CreateAWSBatchComputeEnv(ctx, workspaceId, "tf-acceptance-aws-batch", "", credentialsId, config)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if err := c.SetPrimaryComputeEnv(ctx, workspaceId, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				computeEnv, err = c.GetPrimaryComputeEnv(ctx, workspaceId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if computeEnv == nil || computeEnv.Id != id || !computeEnv.Primary {
+					t.Fatalf("expected compute env %s to be primary, got %+v", id, computeEnv)
+				}
+			},
+		},
+		{
+			name: "update",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateAWSBatchComputeEnv(ctx, workspaceId, "tf-acceptance-aws-batch", "", credentialsId, config)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				environment := []*ComputeEnvConfigEnvVar{{Name: "FOO", Value: "bar", Head: true, Compute: true}}
+
+				err = c.UpdateComputeEnv(ctx, workspaceId, id, "tf-acceptance-aws-batch-updated", "updated", credentialsId, "echo pre", "echo post", environment)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				computeEnv, err := c.GetComputeEnv(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if computeEnv.Name != "tf-acceptance-aws-batch-updated" || computeEnv.Description != "updated" {
+					t.Fatalf("compute env was not updated: %+v", *computeEnv)
+				}
+
+				// only the editable settings of the config change
+				expected := *config
+				expected.PreRunScript = "echo pre"
+				expected.PostRunScript = "echo post"
+				expected.Environment = environment
+
+				if !reflect.DeepEqual(computeEnv.Config, expected) {
+					t.Fatalf("expected config %+v, got %+v", expected, computeEnv.Config)
+				}
+			},
+		},
+		{
+			name: "unknown credentials",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, credentialsId string) {
+				_, err := c.CreateAWSBatchComputeEnv(context.Background(), workspaceId, "tf-acceptance-aws-batch", "", "unknown", config)
+
+				if err == nil {
+					t.Fatal("expected an error for unknown credentials")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRecordedClient(t)
+			workspaceId := createTestWorkspace(t, c)
+			tt.run(t, c, workspaceId, createTestCredentials(t, c, workspaceId))
+		})
+	}
+}
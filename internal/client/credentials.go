@@ -130,6 +130,233 @@ func (c *TowerClient) CreateCredentialsSSH(
 	return c.createCredentials(ctx, workspaceId, payload)
 }
 
+func (c *TowerClient) CreateCredentialsGoogle(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	data string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "google",
+			"keys": map[string]interface{}{
+				"data": data,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsAzure(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	batchName string,
+	batchKey string,
+	storageName string,
+	storageKey string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "azure",
+			"keys": map[string]interface{}{
+				"batchName":   batchName,
+				"batchKey":    batchKey,
+				"storageName": storageName,
+				"storageKey":  storageKey,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsAzureEntra(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	batchName string,
+	storageName string,
+	tenantId string,
+	clientId string,
+	clientSecret string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "azure_entra",
+			"keys": map[string]interface{}{
+				"batchName":    batchName,
+				"storageName":  storageName,
+				"tenantId":     tenantId,
+				"clientId":     clientId,
+				"clientSecret": clientSecret,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsBitbucket(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	baseUrl string,
+	username string,
+	appPassword string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "bitbucket",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": appPassword,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsGitea(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	baseUrl string,
+	username string,
+	password string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "gitea",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": password,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsAzureRepos(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	baseUrl string,
+	username string,
+	accessToken string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "azurerepos",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": accessToken,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsCodeCommit(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	baseUrl string,
+	accessKey string,
+	secretKey string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "codecommit",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": accessKey,
+				"password": secretKey,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsKubernetes(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	token string,
+	certificate string,
+	privateKey string) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "k8s",
+			"keys": map[string]interface{}{
+				"token":       token,
+				"certificate": certificate,
+				"privateKey":  privateKey,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
+func (c *TowerClient) CreateCredentialsTowerAgent(
+	ctx context.Context,
+	workspaceId string,
+	name string,
+	description string,
+	connectionId string,
+	workDir string,
+	shared bool) (string, error) {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"provider":    "tw-agent",
+			"keys": map[string]interface{}{
+				"connectionId": connectionId,
+				"workDir":      workDir,
+				"shared":       shared,
+			},
+		},
+	}
+
+	return c.createCredentials(ctx, workspaceId, payload)
+}
+
 func (c *TowerClient) createCredentials(ctx context.Context, workspaceId string, payload map[string]interface{}) (string, error) {
 	res, err := c.requestWithJsonPayload(ctx, "POST", "/credentials", map[string]string{"workspaceId": workspaceId}, payload)
 
@@ -137,51 +364,60 @@ func (c *TowerClient) createCredentials(ctx context.Context, workspaceId string,
 		return "", err
 	}
 
-	if res == nil {
-		return "", fmt.Errorf("Empty response from server")
+	var credentials struct {
+		CredentialsId string `json:"credentialsId"`
 	}
 
-	credentials := res.(map[string]interface{})
+	if err := decodeResponse(res, &credentials); err != nil {
+		return "", err
+	}
 
-	return credentials["credentialsId"].(string), nil
+	return credentials.CredentialsId, nil
 }
 
-func (c *TowerClient) GetCredentialsByName(ctx context.Context, workspaceId string, name string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", "/credentials", map[string]string{"workspaceId": workspaceId})
+// ListCredentials returns the credentials of a workspace. The keys of each
+// credentials are not included.
+func (c *TowerClient) ListCredentials(ctx context.Context, workspaceId string) ([]Credentials, error) {
+	return listAll[Credentials](ctx, c, "/credentials", map[string]string{"workspaceId": workspaceId}, "credentials", pageSize)
+}
 
-	if err != nil {
-		return nil, err
-	}
+func (c *TowerClient) GetCredentialsByName(ctx context.Context, workspaceId string, name string) (*Credentials, error) {
+	it := newPageIterator[Credentials](c, "/credentials", map[string]string{"workspaceId": workspaceId}, "credentials", pageSize)
 
-	if credentialsList, ok := res.(map[string]interface{}); ok {
-		for _, credentials := range credentialsList["credentials"].([]interface{}) {
-			o, _ := credentials.(map[string]interface{})
-			if o["name"].(string) == name {
-				return c.GetCredentials(ctx, workspaceId, o["id"].(string))
-			}
+	for it.Next(ctx) {
+		if o := it.Item(); o.Name == name {
+			return c.GetCredentials(ctx, workspaceId, o.Id)
 		}
 	}
 
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
 	return nil, fmt.Errorf("Could not find credentials with the name '%s'", name)
 }
 
-func (c *TowerClient) GetCredentials(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+func (c *TowerClient) GetCredentials(ctx context.Context, workspaceId string, id string) (*Credentials, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/credentials/%s", id), map[string]string{"workspaceId": workspaceId})
 
 	if err != nil {
-		if v, ok := err.(towerError); ok {
-			if v.statusCode == 403 {
-				// when the remote credentials have been deleted,
-				// tower returns a 403 instead of a 404 :(
-				return nil, nil
-			}
+		if IsForbidden(err) {
+			// when the remote credentials have been deleted,
+			// tower returns a 403 instead of a 404 :(
+			return nil, nil
 		}
 		return nil, err
 	}
 
-	credentialsObj := res.(map[string]interface{})
+	var credentialsObj struct {
+		Credentials Credentials `json:"credentials"`
+	}
 
-	return credentialsObj["credentials"].(map[string]interface{}), nil
+	if err := decodeResponse(res, &credentialsObj); err != nil {
+		return nil, err
+	}
+
+	return &credentialsObj.Credentials, nil
 }
 
 func (c *TowerClient) DeleteCredentials(ctx context.Context, workspaceId string, id string) error {
@@ -264,6 +500,283 @@ func (c *TowerClient) UpdateCredentialsGithub(
 	return c.updateCredentials(ctx, id, workspaceId, payload)
 }
 
+func (c *TowerClient) UpdateCredentialsGitlab(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	baseUrl string,
+	username string,
+	password string,
+	token string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "gitlab",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": password,
+				"token":    token,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsSSH(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	sshKey string,
+	sshKeyPassphrase string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "ssh",
+			"keys": map[string]interface{}{
+				"privateKey": sshKey,
+				"passphrase": sshKeyPassphrase,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsGoogle(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	data string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "google",
+			"keys": map[string]interface{}{
+				"data": data,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsAzure(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	batchName string,
+	batchKey string,
+	storageName string,
+	storageKey string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "azure",
+			"keys": map[string]interface{}{
+				"batchName":   batchName,
+				"batchKey":    batchKey,
+				"storageName": storageName,
+				"storageKey":  storageKey,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsAzureEntra(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	batchName string,
+	storageName string,
+	tenantId string,
+	clientId string,
+	clientSecret string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "azure_entra",
+			"keys": map[string]interface{}{
+				"batchName":    batchName,
+				"storageName":  storageName,
+				"tenantId":     tenantId,
+				"clientId":     clientId,
+				"clientSecret": clientSecret,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsBitbucket(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	baseUrl string,
+	username string,
+	appPassword string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "bitbucket",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": appPassword,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsGitea(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	baseUrl string,
+	username string,
+	password string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "gitea",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": password,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsAzureRepos(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	baseUrl string,
+	username string,
+	accessToken string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "azurerepos",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": username,
+				"password": accessToken,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsCodeCommit(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	baseUrl string,
+	accessKey string,
+	secretKey string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "codecommit",
+			"baseUrl":     baseUrl,
+			"keys": map[string]interface{}{
+				"username": accessKey,
+				"password": secretKey,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsKubernetes(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	token string,
+	certificate string,
+	privateKey string) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "k8s",
+			"keys": map[string]interface{}{
+				"token":       token,
+				"certificate": certificate,
+				"privateKey":  privateKey,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
+func (c *TowerClient) UpdateCredentialsTowerAgent(
+	ctx context.Context,
+	id string,
+	workspaceId string,
+	description string,
+	connectionId string,
+	workDir string,
+	shared bool) error {
+
+	payload := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"id":          id,
+			"description": description,
+			"provider":    "tw-agent",
+			"keys": map[string]interface{}{
+				"connectionId": connectionId,
+				"workDir":      workDir,
+				"shared":       shared,
+			},
+		},
+	}
+
+	return c.updateCredentials(ctx, id, workspaceId, payload)
+}
+
 func (c *TowerClient) updateCredentials(ctx context.Context, id string, workspaceId string, payload map[string]interface{}) error {
 	_, err := c.requestWithJsonPayload(ctx, "PUT", fmt.Sprintf("/credentials/%s", id), map[string]string{"workspaceId": workspaceId}, payload)
 	return err
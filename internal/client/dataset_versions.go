@@ -2,8 +2,8 @@ package client
 
 import (
 	"context"
-	"strings"
 	"fmt"
+	"strings"
 )
 
 func (c *TowerClient) CreateDatasetVersion(ctx context.Context, workspaceId string, datasetId string, fileContents string, filename string, hasHeader bool) (int, error) {
@@ -13,37 +13,41 @@ func (c *TowerClient) CreateDatasetVersion(ctx context.Context, workspaceId stri
 		return -1, err
 	}
 
-	res, err := c.request(ctx, "POST", fmt.Sprintf("/workspaces/%s/datasets/%s/upload", workspaceId, datasetId), map[string]string{ "header": fmt.Sprintf("%t", hasHeader) }, body, contentType)
+	res, err := c.request(ctx, "POST", fmt.Sprintf("/workspaces/%s/datasets/%s/upload", workspaceId, datasetId), map[string]string{"header": fmt.Sprintf("%t", hasHeader)}, body, contentType)
 
 	if err != nil {
 		return -1, err
 	}
 
-	versionObj := res.(map[string]interface{})
-	version := versionObj["version"].(map[string]interface{})
-	return int(version["version"].(float64)), nil
-}
-
-func (c *TowerClient) GetDatasetVersion(ctx context.Context, workspaceId string, datasetId string, versionId int) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/workspaces/%s/datasets/%s/versions", workspaceId, datasetId), nil)
+	var versionObj struct {
+		Version DatasetVersion `json:"version"`
+	}
 
-	if err != nil {
-		return nil, err
+	if err := decodeResponse(res, &versionObj); err != nil {
+		return -1, err
 	}
 
-	versionsObj := res.(map[string]interface{})
-	for _, v := range versionsObj["versions"].([]interface{}) {
-		version := v.(map[string]interface{})
-		if int(version["version"].(float64)) == versionId {
-			contents, err := c.getDatasetContent(ctx, workspaceId, datasetId, versionId, version["fileName"].(string))
+	return versionObj.Version.Version, nil
+}
+
+func (c *TowerClient) GetDatasetVersion(ctx context.Context, workspaceId string, datasetId string, versionId int) (*DatasetVersion, error) {
+	it := newPageIterator[DatasetVersion](c, fmt.Sprintf("/workspaces/%s/datasets/%s/versions", workspaceId, datasetId), nil, "versions", pageSize)
+
+	for it.Next(ctx) {
+		if version := it.Item(); version.Version == versionId {
+			contents, err := c.getDatasetContent(ctx, workspaceId, datasetId, versionId, version.FileName)
 			if err != nil {
 				return nil, err
 			}
-			version["contents"] = contents
-			return version, nil
+			version.Contents = contents
+			return &version, nil
 		}
 	}
 
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
 	return nil, fmt.Errorf("Could not find version %d for dataset %s", versionId, datasetId)
 }
 
@@ -54,5 +58,11 @@ func (c *TowerClient) getDatasetContent(ctx context.Context, workspaceId string,
 		return "", err
 	}
 
-	return string(res.([]byte)), nil
-}
\ No newline at end of file
+	contents, ok := res.([]byte)
+
+	if !ok {
+		return "", fmt.Errorf("Unexpected response for the contents of dataset %s version %d", datasetId, versionId)
+	}
+
+	return string(contents), nil
+}
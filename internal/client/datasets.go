@@ -17,14 +17,23 @@ func (c *TowerClient) CreateDataset(ctx context.Context, workspaceId string, nam
 		return "", err
 	}
 
-	datasetObj := res.(map[string]interface{})
-	dataset := datasetObj["dataset"].(map[string]interface{})
-	datasetId := dataset["id"].(string)
+	var datasetObj struct {
+		Dataset Dataset `json:"dataset"`
+	}
 
-	return datasetId, nil
+	if err := decodeResponse(res, &datasetObj); err != nil {
+		return "", err
+	}
+
+	return datasetObj.Dataset.Id, nil
 }
 
-func (c *TowerClient) GetDataset(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+// ListDatasets returns the datasets of a workspace.
+func (c *TowerClient) ListDatasets(ctx context.Context, workspaceId string) ([]Dataset, error) {
+	return listAll[Dataset](ctx, c, fmt.Sprintf("/workspaces/%s/datasets", workspaceId), nil, "datasets", pageSize)
+}
+
+func (c *TowerClient) GetDataset(ctx context.Context, workspaceId string, id string) (*Dataset, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/workspaces/%s/datasets/%s/metadata", workspaceId, id), nil)
 
 	if err != nil {
@@ -35,15 +44,19 @@ func (c *TowerClient) GetDataset(ctx context.Context, workspaceId string, id str
 		return nil, nil
 	}
 
-	datasetObj := res.(map[string]interface{})
+	var datasetObj struct {
+		Dataset Dataset `json:"dataset"`
+	}
 
-	dataset := datasetObj["dataset"].(map[string]interface{})
+	if err := decodeResponse(res, &datasetObj); err != nil {
+		return nil, err
+	}
 
-	if dataset["deleted"].(bool) {
+	if datasetObj.Dataset.Deleted {
 		return nil, nil
 	}
 
-	return dataset, nil
+	return &datasetObj.Dataset, nil
 }
 
 func (c *TowerClient) UpdateDataset(ctx context.Context, workspaceId string, id string, name string, description string) error {
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDatasets(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient, workspaceId string)
+	}{
+		{
+			name: "lifecycle",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateDataset(ctx, workspaceId, "tf-acceptance-dataset", "A dataset")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				dataset, err := c.GetDataset(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if dataset.Id != id || dataset.Name != "tf-acceptance-dataset" || dataset.Description != "A dataset" {
+					t.Fatalf("unexpected dataset: %+v", *dataset)
+				}
+
+				if err := c.UpdateDataset(ctx, workspaceId, id, "tf-acceptance-dataset-updated", "An updated dataset"); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				dataset, err = c.GetDataset(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if dataset.Name != "tf-acceptance-dataset-updated" || dataset.Description != "An updated dataset" {
+					t.Fatalf("dataset was not updated: %+v", *dataset)
+				}
+
+				if err := c.DeleteDataset(ctx, workspaceId, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				// tower flags deleted datasets rather than removing them
+				dataset, err = c.GetDataset(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if dataset != nil {
+					t.Fatalf("expected the deleted dataset to be nil, got %+v", *dataset)
+				}
+			},
+		},
+		{
+			name: "versions",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateDataset(ctx, workspaceId, "tf-acceptance-dataset", "")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				first, err := c.CreateDatasetVersion(ctx, workspaceId, id, "one,two\n1,2\n", "first.csv", true)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				second, err := c.CreateDatasetVersion(ctx, workspaceId, id, "a\tb\n1\t2\n3\t4\n", "second.tsv", false)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				version, err := c.GetDatasetVersion(ctx, workspaceId, id, first)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if version.FileName != "first.csv" || version.MediaType != "text/csv" || !version.HasHeader || version.Contents != "one,two\n1,2\n" {
+					t.Fatalf("unexpected version: %+v", *version)
+				}
+
+				version, err = c.GetDatasetVersion(ctx, workspaceId, id, second)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if version.FileName != "second.tsv" || version.MediaType != "text/tab-separated-values" || version.HasHeader || version.Contents != "a\tb\n1\t2\n3\t4\n" {
+					t.Fatalf("unexpected version: %+v", *version)
+				}
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateDataset(ctx, workspaceId, "tf-acceptance-dataset", "")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				deletedId, err := c.CreateDataset(ctx, workspaceId, "tf-acceptance-dataset-deleted", "")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if err := c.DeleteDataset(ctx, workspaceId, deletedId); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				datasets, err := c.ListDatasets(ctx, workspaceId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if len(datasets) != 1 || datasets[0].Id != id {
+					t.Fatalf("expected dataset %s, got %+v", id, datasets)
+				}
+			},
+		},
+		{
+			name: "unknown version",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				ctx := context.Background()
+
+				id, err := c.CreateDataset(ctx, workspaceId, "tf-acceptance-dataset", "")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				version, err := c.GetDatasetVersion(ctx, workspaceId, id, 42)
+
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", version)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRecordedClient(t)
+			tt.run(t, c, createTestWorkspace(t, c))
+		})
+	}
+}
@@ -13,11 +13,9 @@ func (c *TowerClient) createLabels(ctx context.Context, workspaceId string, labe
 		_, err := c.requestWithJsonPayload(ctx, "POST", "/labels", map[string]string{"workspaceId": workspaceId}, payload)
 
 		if err != nil {
-			if v, ok := err.(towerError); ok {
-				if v.statusCode == 409 {
-					// label already exists
-					continue
-				}
+			if IsConflict(err) {
+				// label already exists
+				continue
 			}
 			return nil, err
 		}
@@ -32,30 +30,26 @@ func (c *TowerClient) createLabels(ctx context.Context, workspaceId string, labe
 	labelIds := []int64{}
 
 	for _, l := range labelObjs {
-		lbl := l.(map[string]interface{})
-		labelIds = append(labelIds, int64(lbl["id"].(float64)))
+		labelIds = append(labelIds, l.Id)
 	}
 
 	return labelIds, nil
 }
 
-func (c *TowerClient) getLabels(ctx context.Context, workspaceId string, labels []string) ([]interface{}, error) {
+func (c *TowerClient) getLabels(ctx context.Context, workspaceId string, labels []string) ([]Label, error) {
 	// list all labels
-	res, err := c.requestWithoutPayload(ctx, "GET", "/labels", map[string]string{"workspaceId": workspaceId})
+	remoteLabels, err := listAll[Label](ctx, c, "/labels", map[string]string{"workspaceId": workspaceId}, "labels", pageSize)
 
 	if err != nil {
 		return nil, err
 	}
 
-	remoteLabels := res.(map[string]interface{})
-
-	labelsToReturn := []interface{}{}
+	labelsToReturn := []Label{}
 
 	for _, l := range labels {
-		for _, v := range remoteLabels["labels"].([]interface{}) {
-			rl := v.(map[string]interface{})
-			if l == rl["name"] {
-				labelsToReturn = append(labelsToReturn, v)
+		for _, rl := range remoteLabels {
+			if l == rl.Name {
+				labelsToReturn = append(labelsToReturn, rl)
 			}
 		}
 	}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"encoding/json"
+)
+
+// The types below model the objects returned by the Tower API. Fields that
+// tower omits or returns as null are left as their zero value.
+
+type Organization struct {
+	OrgId    int64  `json:"orgId"`
+	Name     string `json:"name"`
+	FullName string `json:"fullName"`
+}
+
+type Workspace struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"fullName"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	DateCreated string `json:"dateCreated"`
+	LastUpdated string `json:"lastUpdated"`
+}
+
+type ComputeEnv struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Platform      string `json:"platform"`
+	CredentialsId string `json:"credentialsId"`
+	Status        string `json:"status"`
+	Message       string `json:"message"`
+	Primary       bool   `json:"primary"`
+	DateCreated   string `json:"dateCreated"`
+	LastUpdated   string `json:"lastUpdated"`
+	LastUsed      string `json:"lastUsed"`
+	Deleted       bool   `json:"deleted"`
+
+	// Config holds one of the ComputeEnv*Config types, depending on the
+	// platform of the compute environment.
+	Config interface{} `json:"-"`
+
+	RawConfig map[string]interface{} `json:"config"`
+}
+
+type Credentials struct {
+	Id          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Provider    string         `json:"provider"`
+	BaseUrl     string         `json:"baseUrl"`
+	Keys        CredentialKeys `json:"keys"`
+	DateCreated string         `json:"dateCreated"`
+	LastUpdated string         `json:"lastUpdated"`
+	LastUsed    string         `json:"lastUsed"`
+}
+
+// CredentialKeys holds the keys tower returns for credentials. Secrets are
+// never returned, so only the public part of each provider is modelled.
+type CredentialKeys struct {
+	// aws, codecommit
+	AccessKey     string `json:"accessKey"`
+	AssumeRoleArn string `json:"assumeRoleArn"`
+
+	// container registries use userName, git providers use username. The
+	// json decoder matches either casing.
+	Username string `json:"username"`
+	Registry string `json:"registry"`
+
+	// azure
+	BatchName   string `json:"batchName"`
+	StorageName string `json:"storageName"`
+	TenantId    string `json:"tenantId"`
+	ClientId    string `json:"clientId"`
+
+	// tw-agent
+	ConnectionId string `json:"connectionId"`
+	WorkDir      string `json:"workDir"`
+	Shared       bool   `json:"shared"`
+}
+
+type Label struct {
+	Id       int64  `json:"id"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Resource bool   `json:"resource"`
+}
+
+type LaunchComputeEnv struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	Platform string `json:"platform"`
+}
+
+type Launch struct {
+	Id               string            `json:"id"`
+	ComputeEnv       *LaunchComputeEnv `json:"computeEnv"`
+	Pipeline         string            `json:"pipeline"`
+	WorkDir          string            `json:"workDir"`
+	Revision         string            `json:"revision"`
+	ConfigProfiles   []string          `json:"configProfiles"`
+	ParamsText       string            `json:"paramsText"`
+	ConfigText       string            `json:"configText"`
+	TowerConfig      string            `json:"towerConfig"`
+	MainScript       string            `json:"mainScript"`
+	EntryName        string            `json:"entryName"`
+	SchemaName       string            `json:"schemaName"`
+	PreRunScript     string            `json:"preRunScript"`
+	PostRunScript    string            `json:"postRunScript"`
+	WorkspaceSecrets []string          `json:"workspaceSecrets"`
+}
+
+// ComputeEnvId returns the id of the compute environment of the launch, or
+// an empty string when tower did not return one.
+func (l *Launch) ComputeEnvId() string {
+	if l == nil || l.ComputeEnv == nil {
+		return ""
+	}
+
+	return l.ComputeEnv.Id
+}
+
+type Pipeline struct {
+	PipelineId  int64   `json:"pipelineId"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Repository  string  `json:"repository"`
+	Labels      []Label `json:"labels"`
+
+	// Launch is fetched separately from the pipeline itself.
+	Launch *Launch `json:"-"`
+}
+
+type Action struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Source      string  `json:"source"`
+	Status      string  `json:"status"`
+	HookId      string  `json:"hookId"`
+	HookUrl     string  `json:"hookUrl"`
+	Launch      *Launch `json:"launch"`
+	Labels      []Label `json:"labels"`
+	DateCreated string  `json:"dateCreated"`
+	LastUpdated string  `json:"lastUpdated"`
+}
+
+type Dataset struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MediaType   string `json:"mediaType"`
+	Deleted     bool   `json:"deleted"`
+	DateCreated string `json:"dateCreated"`
+	LastUpdated string `json:"lastUpdated"`
+}
+
+type DatasetVersion struct {
+	DatasetId   string `json:"datasetId"`
+	Version     int    `json:"version"`
+	HasHeader   bool   `json:"hasHeader"`
+	FileName    string `json:"fileName"`
+	MediaType   string `json:"mediaType"`
+	Url         string `json:"url"`
+	DateCreated string `json:"dateCreated"`
+	LastUpdated string `json:"lastUpdated"`
+
+	// Contents is downloaded separately from the version metadata.
+	Contents string `json:"-"`
+}
+
+type OrganizationMember struct {
+	MemberId  int64  `json:"memberId"`
+	UserId    int64  `json:"userId"`
+	UserName  string `json:"userName"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Role      string `json:"role"`
+}
+
+type WorkspaceParticipant struct {
+	ParticipantId int64  `json:"participantId"`
+	MemberId      int64  `json:"memberId"`
+	UserName      string `json:"userName"`
+	Email         string `json:"email"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	OrgRole       string `json:"orgRole"`
+	WspRole       string `json:"wspRole"`
+	Type          string `json:"type"`
+}
+
+type Token struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	DateCreated string `json:"dateCreated"`
+	LastUsed    string `json:"lastUsed"`
+}
+
+type PipelineSecret struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	DateCreated string `json:"dateCreated"`
+	LastUpdated string `json:"lastUpdated"`
+	LastUsed    string `json:"lastUsed"`
+}
+
+// decodeResponse decodes a response returned by request into out.
+func decodeResponse(res interface{}, out interface{}) error {
+	if _, ok := res.(map[string]interface{}); !ok {
+		return errEmptyResponse
+	}
+
+	b, err := json.Marshal(res)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
@@ -6,7 +6,7 @@ import (
 )
 
 func (c *TowerClient) CreateOrganizationMember(ctx context.Context, email string, role string) (int64, error) {
-	var member map[string]interface{}
+	var member *OrganizationMember
 
 	payload := map[string]interface{}{
 		"user": email,
@@ -15,31 +15,34 @@ func (c *TowerClient) CreateOrganizationMember(ctx context.Context, email string
 	res, err := c.requestWithJsonPayload(ctx, "PUT", fmt.Sprintf("/orgs/%d/members/add", c.orgId), nil, payload)
 
 	if err != nil {
-		towerErr, ok := err.(towerError)
-		if ok {
-			// If user already exists, update role
-			if towerErr.statusCode == 409 {
-				member, err = c.GetOrganizationMember(ctx, email)
-			}
-			if err != nil {
-				return -1, err
-			}
-		} else {
+		// If user already exists, update role
+		if !IsConflict(err) {
+			return -1, err
+		}
+
+		member, err = c.GetOrganizationMember(ctx, email)
+		if err != nil {
 			return -1, err
 		}
 	} else {
-		if res == nil {
-			return -1, fmt.Errorf("Empty response from server")
+		var memberObj struct {
+			Member OrganizationMember `json:"member"`
+		}
+
+		if err := decodeResponse(res, &memberObj); err != nil {
+			return -1, err
 		}
-		memberObj := res.(map[string]interface{})
-		member = memberObj["member"].(map[string]interface{})
+
+		member = &memberObj.Member
 	}
 
-	memberId := int64(member["memberId"].(float64))
+	if member == nil {
+		return -1, fmt.Errorf("Could not find an organization member with the email '%s'", email)
+	}
 
-	err = c.UpdateOrganizationMemberRole(ctx, memberId, role)
+	err = c.UpdateOrganizationMemberRole(ctx, member.MemberId, role)
 
-	return int64(member["memberId"].(float64)), err
+	return member.MemberId, err
 }
 
 func (c *TowerClient) UpdateOrganizationMemberRole(ctx context.Context, id int64, role string) error {
@@ -51,22 +54,32 @@ func (c *TowerClient) UpdateOrganizationMemberRole(ctx context.Context, id int64
 	return err
 }
 
-func (c *TowerClient) GetOrganizationMember(ctx context.Context, email string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/orgs/%d/members", c.orgId), map[string]string{"search": email})
+// ListOrganizationMembers returns the members of the organization.
+func (c *TowerClient) ListOrganizationMembers(ctx context.Context) ([]OrganizationMember, error) {
+	return listAll[OrganizationMember](ctx, c, fmt.Sprintf("/orgs/%d/members", c.orgId), nil, "members", pageSize)
+}
 
-	if err != nil {
-		return nil, err
+func (c *TowerClient) GetOrganizationMember(ctx context.Context, email string) (*OrganizationMember, error) {
+	it := newPageIterator[OrganizationMember](c, fmt.Sprintf("/orgs/%d/members", c.orgId), map[string]string{"search": email}, "members", pageSize)
+
+	if it.Next(ctx) {
+		member := it.Item()
+		return &member, nil
 	}
 
-	members := res.(map[string]interface{})
+	return nil, it.Err()
+}
 
-	if int64(members["totalSize"].(float64)) == 0 {
-		return nil, nil
-	}
+func (c *TowerClient) GetOrganizationMemberById(ctx context.Context, id int64) (*OrganizationMember, error) {
+	it := newPageIterator[OrganizationMember](c, fmt.Sprintf("/orgs/%d/members", c.orgId), nil, "members", pageSize)
 
-	member := members["members"].([]interface{})
+	for it.Next(ctx) {
+		if member := it.Item(); member.MemberId == id {
+			return &member, nil
+		}
+	}
 
-	return member[0].(map[string]interface{}), nil
+	return nil, it.Err()
 }
 
 func (c *TowerClient) DeleteOrganizationMember(ctx context.Context, id int64) error {
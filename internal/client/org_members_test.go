@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+// createTestOrganizationMember adds a member to the organization, removed
+// when the test finishes.
+func createTestOrganizationMember(t *testing.T, c *TowerClient, email string) int64 {
+	t.Helper()
+
+	ctx := context.Background()
+
+	id, err := c.CreateOrganizationMember(ctx, email, "member")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := c.DeleteOrganizationMember(ctx, id); err != nil {
+			t.Errorf("err: %s", err)
+		}
+	})
+
+	return id
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient)
+	}{
+		{
+			name: "lifecycle",
+			run: func(t *testing.T, c *TowerClient) {
+				ctx := context.Background()
+
+				id, err := c.CreateOrganizationMember(ctx, "tf-acceptance-member@example.com", "member")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				member, err := c.GetOrganizationMember(ctx, "tf-acceptance-member@example.com")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if member.MemberId != id || member.Email != "tf-acceptance-member@example.com" || member.Role != "member" {
+					t.Fatalf("unexpected member: %+v", *member)
+				}
+
+				if err := c.UpdateOrganizationMemberRole(ctx, id, "owner"); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				member, err = c.GetOrganizationMemberById(ctx, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if member.Role != "owner" {
+					t.Fatalf("member role was not updated: %+v", *member)
+				}
+
+				if err := c.DeleteOrganizationMember(ctx, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				member, err = c.GetOrganizationMemberById(ctx, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if member != nil {
+					t.Fatalf("expected the deleted member to be nil, got %+v", *member)
+				}
+			},
+		},
+		{
+			name: "existing member",
+			run: func(t *testing.T, c *TowerClient) {
+				ctx := context.Background()
+
+				id := createTestOrganizationMember(t, c, "tf-acceptance-member@example.com")
+
+				// tower answers 409 for existing members, whose role is
+				// updated instead
+				existingId, err := c.CreateOrganizationMember(ctx, "tf-acceptance-member@example.com", "collaborator")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if existingId != id {
+					t.Fatalf("expected member %d, got %d", id, existingId)
+				}
+
+				member, err := c.GetOrganizationMemberById(ctx, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if member.Role != "collaborator" {
+					t.Fatalf("member role was not updated: %+v", *member)
+				}
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, c *TowerClient) {
+				id := createTestOrganizationMember(t, c, "tf-acceptance-member@example.com")
+
+				members, err := c.ListOrganizationMembers(context.Background())
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				for _, m := range members {
+					if m.MemberId == id {
+						return
+					}
+				}
+
+				t.Fatalf("expected member %d in %+v", id, members)
+			},
+		},
+		{
+			name: "unknown email",
+			run: func(t *testing.T, c *TowerClient) {
+				member, err := c.GetOrganizationMember(context.Background(), "tf-acceptance-unknown@example.com")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if member != nil {
+					t.Fatalf("expected no member, got %+v", *member)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRecordedClient(t))
+		})
+	}
+}
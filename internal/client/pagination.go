@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// pageSize is the number of items requested per page from list endpoints.
+const pageSize = 100
+
+// pageIterator iterates over every item returned by a list endpoint,
+// requesting further pages of size items with max and offset until totalSize
+// items have been read. Endpoints that do not return a totalSize are not
+// paginated by tower, so their first page is also their last.
+//
+//	it := newPageIterator[Label](c, "/labels", query, "labels", pageSize)
+//	for it.Next(ctx) {
+//		label := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type pageIterator[T any] struct {
+	client *TowerClient
+	path   string
+	query  map[string]string
+	key    string
+	size   int
+
+	page   []T
+	index  int
+	offset int
+	last   bool
+	err    error
+}
+
+func newPageIterator[T any](c *TowerClient, path string, query map[string]string, key string, size int) *pageIterator[T] {
+	return &pageIterator[T]{
+		client: c,
+		path:   path,
+		query:  query,
+		key:    key,
+		size:   size,
+		index:  -1,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false once every item has been
+// read or a request fails.
+func (it *pageIterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+
+	for it.index >= len(it.page) {
+		if it.last {
+			return false
+		}
+
+		if err := it.fetch(ctx); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	return true
+}
+
+// Item returns the item the iterator is positioned on.
+func (it *pageIterator[T]) Item() T {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *pageIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *pageIterator[T]) fetch(ctx context.Context) error {
+	query := map[string]string{
+		"max":    fmt.Sprintf("%d", it.size),
+		"offset": fmt.Sprintf("%d", it.offset),
+	}
+	for k, v := range it.query {
+		query[k] = v
+	}
+
+	res, err := it.client.requestListPage(ctx, it.path, query)
+
+	if err != nil {
+		return err
+	}
+
+	var page map[string]json.RawMessage
+
+	if err := decodeResponse(res, &page); err != nil {
+		return err
+	}
+
+	var items []T
+	if raw, ok := page[it.key]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+	}
+
+	var totalSize *int
+	if raw, ok := page["totalSize"]; ok {
+		if err := json.Unmarshal(raw, &totalSize); err != nil {
+			return err
+		}
+	}
+
+	it.page = items
+	it.index = 0
+	it.offset += len(items)
+	it.last = len(items) == 0 || totalSize == nil || it.offset >= *totalSize
+
+	return nil
+}
+
+// requestListPage requests a page of a list endpoint, from the list cache
+// when the client has one.
+func (c *TowerClient) requestListPage(ctx context.Context, path string, query map[string]string) (interface{}, error) {
+	if c.cache == nil {
+		return c.requestWithoutPayload(ctx, "GET", path, query)
+	}
+
+	page, generation, ok := c.cache.get(path, query)
+	if ok {
+		return page, nil
+	}
+
+	page, err := c.requestWithoutPayload(ctx, "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(path, query, page, generation)
+
+	return page, nil
+}
+
+// listAll returns every item of a list endpoint, requested in pages of size
+// items.
+func listAll[T any](ctx context.Context, c *TowerClient, path string, query map[string]string, key string, size int) ([]T, error) {
+	items := []T{}
+
+	it := newPageIterator[T](c, path, query, key, size)
+	for it.Next(ctx) {
+		items = append(items, it.Item())
+	}
+
+	return items, it.Err()
+}
@@ -22,53 +22,59 @@ func (c *TowerClient) CreatePipelineSecrets(
 		return "", err
 	}
 
-	if res == nil {
-		return "", fmt.Errorf("empty response from server")
+	var secrets struct {
+		SecretId int64 `json:"secretId"`
 	}
 
-	secrets := res.(map[string]interface{})
+	if err := decodeResponse(res, &secrets); err != nil {
+		return "", err
+	}
 
-	secretId := fmt.Sprintf("%.0f", secrets["secretId"].(float64))
+	return fmt.Sprintf("%d", secrets.SecretId), nil
+}
 
-	return secretId, nil
+// ListPipelineSecrets returns the pipeline secrets of a workspace.
+func (c *TowerClient) ListPipelineSecrets(ctx context.Context, workspaceId string) ([]PipelineSecret, error) {
+	return listAll[PipelineSecret](ctx, c, "/pipeline-secrets", map[string]string{"workspaceId": workspaceId}, "pipelineSecrets", pageSize)
 }
 
-func (c *TowerClient) GetPipelineSecretByName(ctx context.Context, workspaceId string, name string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", "/pipeline-secrets", map[string]string{"workspaceId": workspaceId})
+func (c *TowerClient) GetPipelineSecretByName(ctx context.Context, workspaceId string, name string) (*PipelineSecret, error) {
+	it := newPageIterator[PipelineSecret](c, "/pipeline-secrets", map[string]string{"workspaceId": workspaceId}, "pipelineSecrets", pageSize)
 
-	if err != nil {
-		return nil, err
+	for it.Next(ctx) {
+		if o := it.Item(); o.Name == name {
+			return c.GetPipelineSecret(ctx, workspaceId, fmt.Sprintf("%d", o.Id))
+		}
 	}
 
-	if piplineSecretsList, ok := res.(map[string]interface{}); ok {
-		for _, pipelineSecrets := range piplineSecretsList["pipelineSecrets"].([]interface{}) {
-			o, _ := pipelineSecrets.(map[string]interface{})
-			if o["name"].(string) == name {
-				return c.GetPipelineSecret(ctx, workspaceId, o["id"].(string))
-			}
-		}
+	if err := it.Err(); err != nil {
+		return nil, err
 	}
 
 	return nil, fmt.Errorf("could not find pipeline-secrets with the name '%s'", name)
 }
 
-func (c *TowerClient) GetPipelineSecret(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+func (c *TowerClient) GetPipelineSecret(ctx context.Context, workspaceId string, id string) (*PipelineSecret, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/pipeline-secrets/%s", id), map[string]string{"workspaceId": workspaceId})
 
 	if err != nil {
-		if v, ok := err.(towerError); ok {
-			if v.statusCode == 403 {
-				// when the remote pipeline-secrets have been deleted,
-				// tower returns a 403 instead of a 404 :(
-				return nil, nil
-			}
+		if IsForbidden(err) {
+			// when the remote pipeline-secrets have been deleted,
+			// tower returns a 403 instead of a 404 :(
+			return nil, nil
 		}
 		return nil, err
 	}
 
-	pipelineSecretsObj := res.(map[string]interface{})
+	var pipelineSecretsObj struct {
+		PipelineSecret PipelineSecret `json:"pipelineSecret"`
+	}
+
+	if err := decodeResponse(res, &pipelineSecretsObj); err != nil {
+		return nil, err
+	}
 
-	return pipelineSecretsObj["pipelineSecret"].(map[string]interface{}), nil
+	return &pipelineSecretsObj.PipelineSecret, nil
 }
 
 func (c *TowerClient) DeletePipelineSecrets(ctx context.Context, workspaceId string, id string) error {
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestPipelineSecrets(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient, workspaceId string)
+	}{
+		{
+			name: "lifecycle",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				ctx := context.Background()
+
+				id, err := c.CreatePipelineSecrets(ctx, workspaceId, "TF_ACCEPTANCE_SECRET", "secret")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				secret, err := c.GetPipelineSecret(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if secret.Name != "TF_ACCEPTANCE_SECRET" {
+					t.Fatalf("unexpected pipeline secret: %+v", *secret)
+				}
+
+				if err := c.UpdatePipelineSecrets(ctx, id, workspaceId, "TF_ACCEPTANCE_SECRET", "another secret"); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				secret, err = c.GetPipelineSecretByName(ctx, workspaceId, "TF_ACCEPTANCE_SECRET")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if fmt.Sprintf("%d", secret.Id) != id {
+					t.Fatalf("expected pipeline secret %s, got %+v", id, *secret)
+				}
+
+				if err := c.DeletePipelineSecrets(ctx, workspaceId, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				// tower answers 403 for deleted pipeline secrets
+				secret, err = c.GetPipelineSecret(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if secret != nil {
+					t.Fatalf("expected the deleted pipeline secret to be nil, got %+v", *secret)
+				}
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				ctx := context.Background()
+
+				id, err := c.CreatePipelineSecrets(ctx, workspaceId, "TF_ACCEPTANCE_SECRET", "secret")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				secrets, err := c.ListPipelineSecrets(ctx, workspaceId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if len(secrets) != 1 || fmt.Sprintf("%d", secrets[0].Id) != id {
+					t.Fatalf("expected pipeline secret %s, got %+v", id, secrets)
+				}
+			},
+		},
+		{
+			name: "unknown name",
+			run: func(t *testing.T, c *TowerClient, workspaceId string) {
+				secret, err := c.GetPipelineSecretByName(context.Background(), workspaceId, "TF_ACCEPTANCE_UNKNOWN")
+
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", secret)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRecordedClient(t)
+			tt.run(t, c, createTestWorkspace(t, c))
+		})
+	}
+}
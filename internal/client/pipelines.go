@@ -63,28 +63,36 @@ func (c *TowerClient) CreatePipeline(
 		return -1, err
 	}
 
-	pipelineObj := res.(map[string]interface{})
-	pl := pipelineObj["pipeline"].(map[string]interface{})
+	var pipelineObj struct {
+		Pipeline Pipeline `json:"pipeline"`
+	}
 
-	return int64(pl["pipelineId"].(float64)), nil
+	if err := decodeResponse(res, &pipelineObj); err != nil {
+		return -1, err
+	}
+
+	return pipelineObj.Pipeline.PipelineId, nil
 }
 
-func (c *TowerClient) GetPipeline(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+func (c *TowerClient) GetPipeline(ctx context.Context, workspaceId string, id string) (*Pipeline, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/pipelines/%s", id), map[string]string{"workspaceId": workspaceId, "attributes": "labels"})
 
 	if err != nil {
-		if v, ok := err.(towerError); ok {
-			if v.statusCode == 403 {
-				// when the remote pipeline has been deleted,
-				// tower returns a 403 instead of a 404 :(
-				return nil, nil
-			}
+		if IsForbidden(err) {
+			// when the remote pipeline has been deleted,
+			// tower returns a 403 instead of a 404 :(
+			return nil, nil
 		}
 		return nil, err
 	}
 
-	pipelineObj := res.(map[string]interface{})
-	pipeline := pipelineObj["pipeline"].(map[string]interface{})
+	var pipelineObj struct {
+		Pipeline Pipeline `json:"pipeline"`
+	}
+
+	if err := decodeResponse(res, &pipelineObj); err != nil {
+		return nil, err
+	}
 
 	launch, err := c.getPipelineLaunchInfo(ctx, workspaceId, id)
 
@@ -92,48 +100,44 @@ func (c *TowerClient) GetPipeline(ctx context.Context, workspaceId string, id st
 		return nil, err
 	}
 
-	// merge the maps
-	for k, v := range launch {
-		pipeline[k] = v
-	}
+	pipelineObj.Pipeline.Launch = launch
 
-	return pipeline, nil
+	return &pipelineObj.Pipeline, nil
 }
 
-func (c *TowerClient) GetPipelineByName(ctx context.Context, workspaceId string, name string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", "/pipelines", map[string]string{"workspaceId": workspaceId, "search": name, "attributes": "labels"})
-
-	if err != nil {
-		return nil, err
-	}
-
-	pipelines := res.(map[string]interface{})
+// ListPipelines returns the pipelines of a workspace, without their Launch.
+func (c *TowerClient) ListPipelines(ctx context.Context, workspaceId string) ([]Pipeline, error) {
+	return listAll[Pipeline](ctx, c, "/pipelines", map[string]string{"workspaceId": workspaceId}, "pipelines", pageSize)
+}
 
-	if int64(pipelines["totalSize"].(float64)) == 0 {
-		return nil, nil
-	}
+func (c *TowerClient) GetPipelineByName(ctx context.Context, workspaceId string, name string) (*Pipeline, error) {
+	it := newPageIterator[Pipeline](c, "/pipelines", map[string]string{"workspaceId": workspaceId, "search": name, "attributes": "labels"}, "pipelines", pageSize)
 
-	for _, pipeline := range pipelines["pipelines"].([]interface{}) {
-		p, _ := pipeline.(map[string]interface{})
-		if p["name"].(string) == name {
-			id := int64(p["pipelineId"].(float64))
-			c.GetPipeline(ctx, workspaceId, fmt.Sprintf("%d", id))
+	for it.Next(ctx) {
+		if p := it.Item(); p.Name == name {
+			return c.GetPipeline(ctx, workspaceId, fmt.Sprintf("%d", p.PipelineId))
 		}
 	}
 
-	return nil, nil
+	return nil, it.Err()
 }
 
-func (c *TowerClient) getPipelineLaunchInfo(ctx context.Context, workspaceId string, id string) (map[string]interface{}, error) {
+func (c *TowerClient) getPipelineLaunchInfo(ctx context.Context, workspaceId string, id string) (*Launch, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/pipelines/%s/launch", id), map[string]string{"workspaceId": workspaceId})
 
 	if err != nil {
 		return nil, err
 	}
 
-	launchObj := res.(map[string]interface{})
+	var launchObj struct {
+		Launch Launch `json:"launch"`
+	}
+
+	if err := decodeResponse(res, &launchObj); err != nil {
+		return nil, err
+	}
 
-	return launchObj["launch"].(map[string]interface{}), nil
+	return &launchObj.Launch, nil
 }
 
 func (c *TowerClient) DeletePipeline(ctx context.Context, workspaceId string, id string) error {
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func labelNames(labels []Label) []string {
+	names := []string{}
+	for _, l := range labels {
+		names = append(names, l.Name)
+	}
+
+	return names
+}
+
+func TestPipelines(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient, workspaceId string, computeEnvId string)
+	}{
+		{
+			name: "lifecycle",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, computeEnvId string) {
+				ctx := context.Background()
+
+				id, err := c.CreatePipeline(
+					ctx, workspaceId, "tf-acceptance-pipeline", "A pipeline", computeEnvId,
+					"https://github.com/nextflow-io/hello", "s3://tf-acceptance/work", "master",
+					"", "", []interface{}{"test"}, "", "", "", "", "", "", []interface{}{},
+					[]string{"tf-acceptance"})
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				pipelineId := fmt.Sprintf("%d", id)
+
+				pipeline, err := c.GetPipeline(ctx, workspaceId, pipelineId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if pipeline.PipelineId != id || pipeline.Name != "tf-acceptance-pipeline" || pipeline.Description != "A pipeline" {
+					t.Fatalf("unexpected pipeline: %+v", *pipeline)
+				}
+
+				if pipeline.Launch.ComputeEnvId() != computeEnvId || pipeline.Launch.Pipeline != "https://github.com/nextflow-io/hello" || pipeline.Launch.Revision != "master" {
+					t.Fatalf("unexpected launch: %+v", *pipeline.Launch)
+				}
+
+				if names := labelNames(pipeline.Labels); len(names) != 1 || names[0] != "tf-acceptance" {
+					t.Fatalf("expected the tf-acceptance label, got %v", names)
+				}
+
+				// the existing label is reused
+				err = c.UpdatePipeline(
+					ctx, workspaceId, pipelineId, "An updated pipeline", computeEnvId,
+					"https://github.com/nextflow-io/hello", "s3://tf-acceptance/work", "main",
+					"echo pre", "", []interface{}{}, "", "", "", "", "", "", []interface{}{},
+					[]string{"tf-acceptance", "updated"})
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				pipeline, err = c.GetPipelineByName(ctx, workspaceId, "tf-acceptance-pipeline")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if pipeline.Description != "An updated pipeline" || pipeline.Launch.Revision != "main" || pipeline.Launch.PreRunScript != "echo pre" {
+					t.Fatalf("pipeline was not updated: %+v %+v", *pipeline, *pipeline.Launch)
+				}
+
+				if names := labelNames(pipeline.Labels); len(names) != 2 {
+					t.Fatalf("expected two labels, got %v", names)
+				}
+
+				if err := c.DeletePipeline(ctx, workspaceId, pipelineId); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				// tower answers 403 for deleted pipelines
+				pipeline, err = c.GetPipeline(ctx, workspaceId, pipelineId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if pipeline != nil {
+					t.Fatalf("expected the deleted pipeline to be nil, got %+v", *pipeline)
+				}
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, computeEnvId string) {
+				ctx := context.Background()
+
+				id, err := c.CreatePipeline(
+					ctx, workspaceId, "tf-acceptance-pipeline", "", computeEnvId,
+					"https://github.com/nextflow-io/hello", "s3://tf-acceptance/work", "",
+					"", "", []interface{}{}, "", "", "", "", "", "", []interface{}{},
+					[]string{})
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				pipelines, err := c.ListPipelines(ctx, workspaceId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if len(pipelines) != 1 || pipelines[0].PipelineId != id || pipelines[0].Name != "tf-acceptance-pipeline" {
+					t.Fatalf("expected pipeline %d, got %+v", id, pipelines)
+				}
+			},
+		},
+		{
+			name: "unknown name",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, computeEnvId string) {
+				pipeline, err := c.GetPipelineByName(context.Background(), workspaceId, "tf-acceptance-unknown")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if pipeline != nil {
+					t.Fatalf("expected no pipeline, got %+v", *pipeline)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRecordedClient(t)
+			workspaceId := createTestWorkspace(t, c)
+			tt.run(t, c, workspaceId, createTestComputeEnv(t, c, workspaceId))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket holding up to burst tokens, refilled at rate
+// tokens per second. Each request takes a token, waiting for one when the
+// bucket is empty.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait takes a token from the bucket, blocking until one is available or ctx
+// is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := time.Now()
+
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// the token is taken straight away, so that concurrent callers queue
+	// behind each other rather than all waking up at once
+	l.tokens--
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// give the token back, the request will not be sent
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// rateLimitedTransport waits for the rate limiter before each request it
+// sends, retries included.
+type rateLimitedTransport struct {
+	limiter *rateLimiter
+	base    http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
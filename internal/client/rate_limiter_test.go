@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     float64
+		burst    int
+		requests int
+		from     time.Duration
+		to       time.Duration
+	}{
+		{name: "within burst", rate: 10, burst: 5, requests: 5, from: 0, to: 50 * time.Millisecond},
+		{name: "beyond burst", rate: 20, burst: 2, requests: 6, from: 200 * time.Millisecond, to: 600 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(tt.rate, tt.burst)
+
+			start := time.Now()
+			for i := 0; i < tt.requests; i++ {
+				if err := l.Wait(context.Background()); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+			}
+
+			if elapsed := time.Since(start); elapsed < tt.from || elapsed > tt.to {
+				t.Fatalf("expected %d requests to take between %s and %s, took %s", tt.requests, tt.from, tt.to, elapsed)
+			}
+		})
+	}
+}
+
+func TestRateLimiterCancelled(t *testing.T) {
+	l := newRateLimiter(1, 1)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+
+	// the token of the cancelled wait was given back
+	if l.tokens < -0.1 {
+		t.Fatalf("expected the token to be given back, got %f tokens", l.tokens)
+	}
+}
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/healx/terraform-provider-nftower/internal/towertest"
+)
+
+// The client tests replay HTTP interactions stored as fixtures in
+// testdata/fixtures, one file per test named after it.
+//
+// The fixtures are recorded against the fake Tower API of internal/towertest,
+// not against a real tower: they check the client against the behaviour the
+// fake implements, which may miss quirks of the live service. Set
+// NFTOWER_RECORD=1 to record them again. Setting NFTOWER_API_URL as well,
+// together with NFTOWER_API_KEY and NFTOWER_ORGANIZATION, records them
+// against that Tower API instead, creating and deleting real objects.
+
+type fixture struct {
+	Organization string        `json:"organization"`
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Query  map[string]string `json:"query,omitempty"`
+	Body   json.RawMessage   `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	Status      int               `json:"status"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Body        json.RawMessage   `json:"body,omitempty"`
+	Text        string            `json:"text,omitempty"`
+	ContentType string            `json:"contentType,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in fixtures.
+var recordedHeaders = []string{"X-Request-Id"}
+
+// newRecordedClient returns a client for the current test which replays the
+// fixture of the test, or records it when NFTOWER_RECORD is set.
+func newRecordedClient(t *testing.T) *TowerClient {
+	t.Helper()
+
+	path := filepath.Join("testdata", "fixtures", filepath.FromSlash(t.Name())+".json")
+
+	var server *httptest.Server
+	var apiKey string
+	var f *fixture
+
+	if os.Getenv("NFTOWER_RECORD") == "1" {
+		apiUrl := os.Getenv("NFTOWER_API_URL")
+		apiKey = os.Getenv("NFTOWER_API_KEY")
+		org := os.Getenv("NFTOWER_ORGANIZATION")
+
+		if apiUrl == "" {
+			fake := towertest.NewServer()
+			t.Cleanup(fake.Close)
+
+			apiUrl, apiKey, org = fake.URL, towertest.APIKey, towertest.Organization
+		}
+
+		f = &fixture{Organization: org}
+
+		r := &recorder{t: t, upstream: apiUrl, fixture: f}
+		server = httptest.NewServer(r)
+
+		t.Cleanup(func() {
+			server.Close()
+			r.save(path)
+		})
+	} else {
+		f = loadFixture(t, path)
+
+		apiKey = "replay"
+		server = newReplayServer(t, f)
+	}
+
+	// the fixtures hold every request a test makes, so the list cache is
+	// disabled to record and replay them all
+	opts := DefaultOptions()
+	opts.ListCacheTTL = 0
+
+	c, err := NewTowerClientWithOptions(context.Background(), "nftower-client-tests", apiKey, server.URL, f.Organization, opts)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return c
+}
+
+func loadFixture(t *testing.T, path string) *fixture {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read fixture, record it with NFTOWER_RECORD=1: %s", err)
+	}
+
+	f := &fixture{}
+	if err := json.Unmarshal(b, f); err != nil {
+		t.Fatalf("invalid fixture %s: %s", path, err)
+	}
+
+	return f
+}
+
+// newReplayServer returns a server replaying the interactions of f, which
+// fails the test when they are not all replayed.
+func newReplayServer(t *testing.T, f *fixture) *httptest.Server {
+	r := &replayer{t: t, interactions: f.Interactions}
+	server := httptest.NewServer(r)
+
+	t.Cleanup(func() {
+		server.Close()
+		r.done()
+	})
+
+	return server
+}
+
+func newRecordedRequest(r *http.Request, body []byte) recordedRequest {
+	req := recordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+	}
+
+	query := r.URL.Query()
+	if len(query) > 0 {
+		req.Query = map[string]string{}
+		for k := range query {
+			req.Query[k] = query.Get(k)
+		}
+	}
+
+	// multipart uploads use a random boundary, so only json bodies are kept
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") && len(body) > 0 {
+		req.Body = json.RawMessage(body)
+	}
+
+	return req
+}
+
+// recorder proxies requests to a Tower API and records the interactions.
+type recorder struct {
+	t        *testing.T
+	upstream string
+
+	mu      sync.Mutex
+	fixture *fixture
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	u, _ := url.Parse(r.upstream)
+	u = u.JoinPath(req.URL.Path)
+	u.RawQuery = req.URL.RawQuery
+
+	proxied, err := http.NewRequestWithContext(req.Context(), req.Method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		r.t.Errorf("err: %s", err)
+		return
+	}
+	proxied.Header = req.Header.Clone()
+
+	res, err := http.DefaultClient.Do(proxied)
+	if err != nil {
+		r.t.Errorf("err: %s", err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer res.Body.Close()
+
+	resBody, _ := io.ReadAll(res.Body)
+
+	recorded := recordedResponse{
+		Status:      res.StatusCode,
+		ContentType: res.Header.Get("Content-Type"),
+	}
+
+	for _, h := range recordedHeaders {
+		if v := res.Header.Get(h); v != "" {
+			if recorded.Headers == nil {
+				recorded.Headers = map[string]string{}
+			}
+			recorded.Headers[h] = v
+		}
+	}
+
+	if json.Valid(resBody) && len(resBody) > 0 {
+		recorded.Body = json.RawMessage(resBody)
+	} else {
+		recorded.Text = string(resBody)
+	}
+
+	r.mu.Lock()
+	r.fixture.Interactions = append(r.fixture.Interactions, interaction{
+		Request:  newRecordedRequest(req, body),
+		Response: recorded,
+	})
+	r.mu.Unlock()
+
+	recorded.write(w)
+}
+
+func (r *recorder) save(path string) {
+	b, err := json.MarshalIndent(r.fixture, "", "  ")
+	if err != nil {
+		r.t.Errorf("err: %s", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		r.t.Errorf("err: %s", err)
+		return
+	}
+
+	if err := os.WriteFile(path, append(b, '\n'), 0644); err != nil {
+		r.t.Errorf("err: %s", err)
+	}
+}
+
+// replayer answers requests with the responses of a fixture, in order.
+type replayer struct {
+	t *testing.T
+
+	mu           sync.Mutex
+	interactions []interaction
+	next         int
+}
+
+func (r *replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	got := newRecordedRequest(req, body)
+
+	if r.next >= len(r.interactions) {
+		r.t.Errorf("unexpected request %s %s, the fixture has no more interactions", got.Method, got.Path)
+		// answer with a status the client does not retry
+		w.WriteHeader(http.StatusTeapot)
+		return
+	}
+
+	expected := r.interactions[r.next]
+	r.next++
+
+	if err := compareRequests(expected.Request, got); err != nil {
+		r.t.Errorf("interaction %d: %s", r.next, err)
+		w.WriteHeader(http.StatusTeapot)
+		return
+	}
+
+	expected.Response.write(w)
+}
+
+func (r *replayer) done() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.next < len(r.interactions) {
+		next := r.interactions[r.next].Request
+		r.t.Errorf("%d interactions were not replayed, the next one is %s %s", len(r.interactions)-r.next, next.Method, next.Path)
+	}
+}
+
+func compareRequests(expected recordedRequest, got recordedRequest) error {
+	if expected.Method != got.Method || expected.Path != got.Path {
+		return fmt.Errorf("expected request %s %s, got %s %s", expected.Method, expected.Path, got.Method, got.Path)
+	}
+
+	if len(expected.Query) > 0 || len(got.Query) > 0 {
+		if !reflect.DeepEqual(expected.Query, got.Query) {
+			return fmt.Errorf("expected query %v for %s %s, got %v", expected.Query, got.Method, got.Path, got.Query)
+		}
+	}
+
+	if expected.Body != nil || got.Body != nil {
+		var expectedBody, gotBody interface{}
+
+		json.Unmarshal(expected.Body, &expectedBody)
+		json.Unmarshal(got.Body, &gotBody)
+
+		if !reflect.DeepEqual(expectedBody, gotBody) {
+			return fmt.Errorf("expected body %s for %s %s, got %s", expected.Body, got.Method, got.Path, got.Body)
+		}
+	}
+
+	return nil
+}
+
+func (res recordedResponse) write(w http.ResponseWriter) {
+	for k, v := range res.Headers {
+		w.Header().Set(k, v)
+	}
+
+	if res.ContentType != "" {
+		w.Header().Set("Content-Type", res.ContentType)
+	}
+
+	w.WriteHeader(res.Status)
+
+	if res.Body != nil {
+		w.Write(res.Body)
+	} else {
+		w.Write([]byte(res.Text))
+	}
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// requestMethodKey is the context key holding the method of a request, as
+// the retry policy is not given the request itself.
+type requestMethodKey struct{}
+
+func withRequestMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, requestMethodKey{}, method)
+}
+
+// checkRetry retries the same requests as retryablehttp, except for POSTs:
+// they create objects, so retrying one which reached tower could create the
+// object twice. POSTs are only retried when tower rejected them with a 429,
+// or when the connection to tower could not be established.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+
+	if !retry || checkErr != nil {
+		return retry, checkErr
+	}
+
+	if method, _ := ctx.Value(requestMethodKey{}).(string); method != http.MethodPost {
+		return true, nil
+	}
+
+	if resp != nil {
+		return resp.StatusCode == http.StatusTooManyRequests, nil
+	}
+
+	return isDialError(err), nil
+}
+
+// isDialError reports whether err happened while connecting to tower, in
+// which case the request was never sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff waits for as long as tower asks in the Retry-After header of 429
+// and 503 responses. Otherwise it backs off exponentially from min to max,
+// with some jitter so that the requests terraform sends in parallel do not
+// all retry at once.
+func backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return wait
+		}
+	}
+
+	wait := time.Duration(math.Pow(2, float64(attemptNum)) * float64(min))
+	if wait <= 0 || wait > max {
+		wait = max
+	}
+
+	// up to a quarter of the wait is random
+	if jitter := int64(wait / 4); jitter > 0 {
+		wait = wait - time.Duration(jitter) + time.Duration(rand.Int63n(jitter))
+	}
+
+	return wait
+}
+
+// parseRetryAfter parses a Retry-After header, given either as a number of
+// seconds or as an HTTP date.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+
+	return 0, true
+}
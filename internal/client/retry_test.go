@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCheckRetry(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name     string
+		method   string
+		status   int
+		err      error
+		expected bool
+	}{
+		{name: "get ok", method: "GET", status: 200, expected: false},
+		{name: "get not found", method: "GET", status: 404, expected: false},
+		{name: "get too many requests", method: "GET", status: 429, expected: true},
+		{name: "get bad gateway", method: "GET", status: 502, expected: true},
+		{name: "get connection reset", method: "GET", err: readErr, expected: true},
+		{name: "put bad gateway", method: "PUT", status: 502, expected: true},
+		{name: "delete unavailable", method: "DELETE", status: 503, expected: true},
+		{name: "post too many requests", method: "POST", status: 429, expected: true},
+		{name: "post bad gateway", method: "POST", status: 502, expected: false},
+		{name: "post unavailable", method: "POST", status: 503, expected: false},
+		{name: "post connection refused", method: "POST", err: dialErr, expected: true},
+		{name: "post connection reset", method: "POST", err: readErr, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status}
+			}
+
+			retry, err := checkRetry(withRequestMethod(context.Background(), tt.method), resp, tt.err)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if retry != tt.expected {
+				t.Fatalf("expected retry to be %t, got %t", tt.expected, retry)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	min := 1 * time.Second
+	max := 30 * time.Second
+
+	tests := []struct {
+		name       string
+		attempt    int
+		status     int
+		retryAfter string
+		from       time.Duration
+		to         time.Duration
+	}{
+		{name: "first attempt", attempt: 0, status: 502, from: 750 * time.Millisecond, to: time.Second},
+		{name: "third attempt", attempt: 2, status: 502, from: 3 * time.Second, to: 4 * time.Second},
+		{name: "capped", attempt: 10, status: 502, from: 22500 * time.Millisecond, to: max},
+		{name: "retry after seconds", attempt: 0, status: 429, retryAfter: "42", from: 42 * time.Second, to: 42 * time.Second},
+		{name: "retry after beyond max", attempt: 0, status: 503, retryAfter: "120", from: 120 * time.Second, to: 120 * time.Second},
+		{name: "retry after ignored", attempt: 0, status: 502, retryAfter: "42", from: 750 * time.Millisecond, to: time.Second},
+		{name: "invalid retry after", attempt: 0, status: 429, retryAfter: "soon", from: 750 * time.Millisecond, to: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+
+			wait := backoff(min, max, tt.attempt, resp)
+
+			if wait < tt.from || wait > tt.to {
+				t.Fatalf("expected a wait between %s and %s, got %s", tt.from, tt.to, wait)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		{header: "", ok: false},
+		{header: "0", expected: 0, ok: true},
+		{header: "5", expected: 5 * time.Second, ok: true},
+		{header: "-5", ok: false},
+		{header: "Mon, 01 Jan 2024 12:01:30 GMT", expected: 90 * time.Second, ok: true},
+		{header: "Mon, 01 Jan 2024 11:00:00 GMT", expected: 0, ok: true},
+		{header: "tomorrow", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			wait, ok := parseRetryAfter(tt.header, now)
+
+			if ok != tt.ok || wait != tt.expected {
+				t.Fatalf("expected (%s, %t), got (%s, %t)", tt.expected, tt.ok, wait, ok)
+			}
+		})
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+		attempts int32
+		status   int
+	}{
+		{name: "get retried", method: "GET", statuses: []int{502, 429, 200}, attempts: 3, status: 200},
+		{name: "get retries exhausted", method: "GET", statuses: []int{502, 502, 502}, attempts: 3, status: 502},
+		{name: "post not retried", method: "POST", statuses: []int{502, 200}, attempts: 1, status: 502},
+		{name: "post rate limited", method: "POST", statuses: []int{429, 200}, attempts: 2, status: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/orgs" {
+					w.Header().Set("Content-Type", "application/json")
+					w.Write([]byte(`{"organizations": [{"orgId": 1, "name": "tf-acceptance"}]}`))
+					return
+				}
+
+				status := tt.statuses[atomic.AddInt32(&attempts, 1)-1]
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "0")
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			opts := DefaultOptions()
+			opts.MaxRetries = 2
+			opts.RetryWaitMin = time.Millisecond
+			opts.RetryWaitMax = time.Millisecond
+
+			c, err := NewTowerClientWithOptions(context.Background(), "nftower-client-tests", "test", server.URL, "tf-acceptance", opts)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			_, err = c.requestWithJsonPayload(context.Background(), tt.method, "/objects", nil, map[string]interface{}{})
+
+			if tt.status == http.StatusOK && err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if tt.status != http.StatusOK && !hasStatusCode(err, tt.status) {
+				t.Fatalf("expected a %d TowerError, got %v", tt.status, err)
+			}
+
+			if attempts != tt.attempts {
+				t.Fatalf("expected %d attempts, got %d", tt.attempts, attempts)
+			}
+		})
+	}
+}
@@ -17,34 +17,38 @@ func (c *TowerClient) CreateToken(ctx context.Context, name string) (string, str
 		return "", "", err
 	}
 
-	tokenObj := res.(map[string]interface{})
-	token := tokenObj["token"].(map[string]interface{})
+	var tokenObj struct {
+		Token     Token  `json:"token"`
+		AccessKey string `json:"accessKey"`
+	}
 
-	return fmt.Sprintf("%d", int64(token["id"].(float64))), tokenObj["accessKey"].(string), nil
-}
+	if err := decodeResponse(res, &tokenObj); err != nil {
+		return "", "", err
+	}
 
-func (c *TowerClient) GetToken(ctx context.Context, id string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", "/tokens", nil)
+	return fmt.Sprintf("%d", tokenObj.Token.Id), tokenObj.AccessKey, nil
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ListTokens returns the access tokens of the user of the client.
+func (c *TowerClient) ListTokens(ctx context.Context) ([]Token, error) {
+	return listAll[Token](ctx, c, "/tokens", nil, "tokens", pageSize)
+}
 
-	tokensObj := res.(map[string]interface{})
+func (c *TowerClient) GetToken(ctx context.Context, id string) (*Token, error) {
 	tokenId, _ := strconv.ParseInt(id, 10, 64)
 
-	for _, v := range tokensObj["tokens"].([]interface{}) {
-		token := v.(map[string]interface{})
+	it := newPageIterator[Token](c, "/tokens", nil, "tokens", pageSize)
 
-		if int64(token["id"].(float64)) == tokenId {
-			return token, nil
+	for it.Next(ctx) {
+		if token := it.Item(); token.Id == tokenId {
+			return &token, nil
 		}
 	}
 
-	return nil, nil
+	return nil, it.Err()
 }
 
 func (c *TowerClient) DeleteToken(ctx context.Context, id string) error {
 	_, err := c.requestWithoutPayload(ctx, "DELETE", fmt.Sprintf("/tokens/%s", id), nil)
 	return err
-}
\ No newline at end of file
+}
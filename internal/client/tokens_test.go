@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		pageSize int
+		tokens   int
+	}{
+		{
+			name:     "single page",
+			pageSize: 100,
+			tokens:   1,
+		},
+		{
+			name:     "several pages",
+			pageSize: 2,
+			tokens:   5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := newRecordedClient(t)
+
+			ids := []string{}
+			for i := 0; i < tt.tokens; i++ {
+				id, accessKey, err := c.CreateToken(ctx, fmt.Sprintf("tf-acceptance-token-%d", i))
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if accessKey == "" {
+					t.Fatalf("expected an access key for token %s", id)
+				}
+
+				ids = append(ids, id)
+			}
+
+			tokens, err := listAll[Token](ctx, c, "/tokens", nil, "tokens", tt.pageSize)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if len(tokens) < tt.tokens {
+				t.Fatalf("expected at least %d tokens, got %+v", tt.tokens, tokens)
+			}
+
+			if tokens[len(tokens)-1].Name != fmt.Sprintf("tf-acceptance-token-%d", tt.tokens-1) {
+				t.Fatalf("expected the last token to be on the last page, got %+v", tokens)
+			}
+
+			last := ids[len(ids)-1]
+
+			token, err := c.GetToken(ctx, last)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if token == nil || fmt.Sprintf("%d", token.Id) != last || token.Name != fmt.Sprintf("tf-acceptance-token-%d", tt.tokens-1) {
+				t.Fatalf("unexpected token: %+v", token)
+			}
+
+			for _, id := range ids {
+				if err := c.DeleteToken(ctx, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+			}
+
+			token, err = c.GetToken(ctx, last)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if token != nil {
+				t.Fatalf("expected the deleted token to be nil, got %+v", *token)
+			}
+		})
+	}
+}
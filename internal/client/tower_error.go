@@ -1,17 +1,81 @@
 package client
 
-type towerError struct {
-	err        error
-	statusCode int
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TowerError is returned when the Tower API responds with an error status.
+// Use errors.As, or one of the Is* helpers, to inspect it.
+type TowerError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	RequestId  string
+
+	// Message is the message field of the error body returned by tower, or
+	// the raw body when it could not be parsed.
+	Message string
 }
 
-func (e towerError) Error() string {
-	return e.err.Error()
+func (e *TowerError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Tower API returned status %d %s for %s %s", e.StatusCode, http.StatusText(e.StatusCode), e.Method, e.Path)
+
+	if e.Message != "" {
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	}
+	if e.RequestId != "" {
+		fmt.Fprintf(&sb, " (request id: %s)", e.RequestId)
+	}
+
+	return sb.String()
 }
 
-func newTowerError(err error, statusCode int) towerError {
-	return towerError{
-		err:        err,
-		statusCode: statusCode,
+func newTowerError(res *http.Response, body []byte) *TowerError {
+	towerErr := &TowerError{
+		StatusCode: res.StatusCode,
+		RequestId:  res.Header.Get("X-Request-Id"),
+		Message:    strings.TrimSpace(string(body)),
+	}
+
+	if res.Request != nil {
+		towerErr.Method = res.Request.Method
+		towerErr.Path = res.Request.URL.Path
 	}
+
+	var errorBody struct {
+		Message string `json:"message"`
+	}
+
+	if err := json.Unmarshal(body, &errorBody); err == nil && errorBody.Message != "" {
+		towerErr.Message = errorBody.Message
+	}
+
+	return towerErr
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var towerErr *TowerError
+
+	return errors.As(err, &towerErr) && towerErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is a TowerError with a 404 status.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is a TowerError with a 409 status.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsForbidden reports whether err is a TowerError with a 403 status.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
 }
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The fixtures of these tests are written by hand, as errors such as these
+// cannot be triggered at will against a Tower API.
+func TestTowerError(t *testing.T) {
+	if os.Getenv("NFTOWER_RECORD") == "1" {
+		t.Skip("the fixtures of this test are written by hand")
+	}
+
+	tests := []struct {
+		name     string
+		expected TowerError
+		is       func(err error) bool
+	}{
+		{
+			name: "json message",
+			expected: TowerError{
+				StatusCode: 400,
+				Method:     "GET",
+				Path:       "/orgs/1/workspaces/42",
+				RequestId:  "5d6e7f80",
+				Message:    "Invalid workspace id",
+			},
+			is: func(err error) bool { return !IsNotFound(err) && !IsConflict(err) && !IsForbidden(err) },
+		},
+		{
+			name: "text body",
+			expected: TowerError{
+				StatusCode: 404,
+				Method:     "GET",
+				Path:       "/orgs/1/workspaces/42",
+				Message:    "Not Found",
+			},
+			is: IsNotFound,
+		},
+		{
+			name: "empty body",
+			expected: TowerError{
+				StatusCode: 403,
+				Method:     "GET",
+				Path:       "/orgs/1/workspaces/42",
+			},
+			is: IsForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRecordedClient(t)
+
+			workspace, err := c.GetWorkspace(context.Background(), 42)
+
+			var towerErr *TowerError
+			if !errors.As(err, &towerErr) {
+				t.Fatalf("expected a TowerError, got %v %+v", err, workspace)
+			}
+
+			if *towerErr != tt.expected {
+				t.Fatalf("expected %+v, got %+v", tt.expected, *towerErr)
+			}
+
+			if !tt.is(err) {
+				t.Fatalf("unexpected status helper result for %s", err)
+			}
+		})
+	}
+}
+
+func TestNewTowerClientUnauthorized(t *testing.T) {
+	if os.Getenv("NFTOWER_RECORD") == "1" {
+		t.Skip("the fixtures of this test are written by hand")
+	}
+
+	f := loadFixture(t, filepath.Join("testdata", "fixtures", t.Name()+".json"))
+	server := newReplayServer(t, f)
+
+	_, err := NewTowerClient(context.Background(), "nftower-client-tests", "unknown", server.URL, f.Organization)
+
+	if !hasStatusCode(err, http.StatusUnauthorized) {
+		t.Fatalf("expected a 401 TowerError, got %v", err)
+	}
+}
@@ -17,24 +17,21 @@ func (c *TowerClient) CreateWorkspaceParticipant(ctx context.Context, workspaceI
 
 	participantExists := false
 	if err != nil {
-		towerErr, ok := err.(towerError)
-		if ok {
-			if towerErr.statusCode == 409 {
-				participantExists = true
-			}
-		} else {
+		if !IsConflict(err) {
 			return -1, "", err
 		}
+
+		participantExists = true
 	}
 
-	var participantObj map[string]interface{}
+	var participant *WorkspaceParticipant
 	if participantExists {
 		ctx = tflog.SetField(ctx, "organizationId", c.orgId)
 		ctx = tflog.SetField(ctx, "workspaceId", workspaceId)
 		ctx = tflog.SetField(ctx, "memberId", memberId)
 		tflog.Debug(ctx, "Member already exists, updating current state and role")
 
-		participant, err := c.GetWorkspaceParticipantByMemberId(ctx, workspaceId, memberId)
+		participant, err = c.GetWorkspaceParticipantByMemberId(ctx, workspaceId, memberId)
 
 		if err != nil {
 			return -1, "", err
@@ -43,28 +40,26 @@ func (c *TowerClient) CreateWorkspaceParticipant(ctx context.Context, workspaceI
 		if participant == nil {
 			return -1, "", fmt.Errorf("No matching participant found with member ID: %d in workspace: %s", memberId, workspaceId)
 		}
-
-		participantObj = map[string]interface{}{"participant": participant}
 	} else {
-		if res == nil {
-			return -1, "", fmt.Errorf("Empty response from server")
-		}
-
 		ctx = tflog.SetField(ctx, "organizationId", c.orgId)
 		ctx = tflog.SetField(ctx, "workspaceId", workspaceId)
 		ctx = tflog.SetField(ctx, "memberId", memberId)
 		tflog.Debug(ctx, "Member created, updating role")
 
-		participantObj = res.(map[string]interface{})
-	}
+		var participantObj struct {
+			Participant WorkspaceParticipant `json:"participant"`
+		}
 
-	participant := participantObj["participant"].(map[string]interface{})
+		if err := decodeResponse(res, &participantObj); err != nil {
+			return -1, "", err
+		}
 
-	participantId := int64(participant["participantId"].(float64))
+		participant = &participantObj.Participant
+	}
 
-	err = c.UpdateWorkspaceParticipantRole(ctx, workspaceId, participantId, role)
+	err = c.UpdateWorkspaceParticipantRole(ctx, workspaceId, participant.ParticipantId, role)
 
-	return participantId, participant["email"].(string), err
+	return participant.ParticipantId, participant.Email, err
 }
 
 func (c *TowerClient) UpdateWorkspaceParticipantRole(ctx context.Context, workspaceId string, id int64, role string) error {
@@ -76,57 +71,54 @@ func (c *TowerClient) UpdateWorkspaceParticipantRole(ctx context.Context, worksp
 	return err
 }
 
-func (c *TowerClient) GetWorkspaceParticipants(ctx context.Context, workspaceId string, search map[string]string) ([]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/orgs/%d/workspaces/%s/participants", c.orgId, workspaceId), search)
+func (c *TowerClient) GetWorkspaceParticipants(ctx context.Context, workspaceId string, search map[string]string) ([]WorkspaceParticipant, error) {
+	return listAll[WorkspaceParticipant](ctx, c, fmt.Sprintf("/orgs/%d/workspaces/%s/participants", c.orgId, workspaceId), search, "participants", pageSize)
+}
+
+func (c *TowerClient) GetWorkspaceParticipantByMemberEmail(ctx context.Context, workspaceId string, email string) (*WorkspaceParticipant, error) {
+	participants, err := c.GetWorkspaceParticipants(ctx, workspaceId, map[string]string{"search": email})
 
 	if err != nil {
 		return nil, err
 	}
 
-	participants := res.(map[string]interface{})
-
-	if int64(participants["totalSize"].(float64)) == 0 {
+	if len(participants) == 0 {
 		return nil, nil
 	}
 
-	return participants["participants"].([]interface{}), nil
+	return &participants[0], nil
 }
 
-func (c *TowerClient) GetWorkspaceParticipantByMemberEmail(ctx context.Context, workspaceId string, email string) (map[string]interface{}, error) {
-	participants, err := c.GetWorkspaceParticipants(ctx, workspaceId, map[string]string{"search": email})
+func (c *TowerClient) GetWorkspaceParticipantByMemberId(ctx context.Context, workspaceId string, memberId int64) (*WorkspaceParticipant, error) {
+	participants, err := c.GetWorkspaceParticipants(ctx, workspaceId, map[string]string{})
 
 	if err != nil {
 		return nil, err
 	}
 
-	if participants == nil {
-		return nil, nil
+	for _, p := range participants {
+		if p.MemberId == memberId {
+			return &p, nil
+		}
 	}
 
-	return participants[0].(map[string]interface{}), nil
+	return nil, nil
 }
 
-func (c *TowerClient) GetWorkspaceParticipantByMemberId(ctx context.Context, workspaceId string, memberId int64) (map[string]interface{}, error) {
+func (c *TowerClient) GetWorkspaceParticipantById(ctx context.Context, workspaceId string, id int64) (*WorkspaceParticipant, error) {
 	participants, err := c.GetWorkspaceParticipants(ctx, workspaceId, map[string]string{})
 
 	if err != nil {
 		return nil, err
 	}
 
-	if participants == nil {
-		return nil, nil
-	}
-
-	var participant map[string]interface{}
-	for _, value := range participants {
-		p := value.(map[string]interface{})
-		if int64(p["memberId"].(float64)) == memberId {
-			participant = p
-			break
+	for _, p := range participants {
+		if p.ParticipantId == id {
+			return &p, nil
 		}
 	}
 
-	return participant, nil
+	return nil, nil
 }
 
 func (c *TowerClient) DeleteWorkspaceParticipant(ctx context.Context, workspaceId string, id int64) error {
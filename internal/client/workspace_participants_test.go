@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWorkspaceParticipants(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient, workspaceId string, memberId int64)
+	}{
+		{
+			name: "lifecycle",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, memberId int64) {
+				ctx := context.Background()
+
+				id, email, err := c.CreateWorkspaceParticipant(ctx, workspaceId, memberId, "maintain")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if email != "tf-acceptance-participant@example.com" {
+					t.Fatalf("unexpected email %q", email)
+				}
+
+				participant, err := c.GetWorkspaceParticipantById(ctx, workspaceId, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if participant.MemberId != memberId || participant.WspRole != "maintain" {
+					t.Fatalf("unexpected participant: %+v", *participant)
+				}
+
+				if err := c.UpdateWorkspaceParticipantRole(ctx, workspaceId, id, "view"); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				participant, err = c.GetWorkspaceParticipantByMemberEmail(ctx, workspaceId, email)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if participant.ParticipantId != id || participant.WspRole != "view" {
+					t.Fatalf("participant role was not updated: %+v", *participant)
+				}
+
+				if err := c.DeleteWorkspaceParticipant(ctx, workspaceId, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				participant, err = c.GetWorkspaceParticipantByMemberId(ctx, workspaceId, memberId)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if participant != nil {
+					t.Fatalf("expected the deleted participant to be nil, got %+v", *participant)
+				}
+			},
+		},
+		{
+			name: "existing participant",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, memberId int64) {
+				ctx := context.Background()
+
+				id, _, err := c.CreateWorkspaceParticipant(ctx, workspaceId, memberId, "launch")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				// tower answers 409 for existing participants, whose role is
+				// updated instead
+				existingId, _, err := c.CreateWorkspaceParticipant(ctx, workspaceId, memberId, "admin")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if existingId != id {
+					t.Fatalf("expected participant %d, got %d", id, existingId)
+				}
+
+				participants, err := c.GetWorkspaceParticipants(ctx, workspaceId, map[string]string{})
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if len(participants) != 1 || participants[0].WspRole != "admin" {
+					t.Fatalf("expected a single admin participant, got %+v", participants)
+				}
+			},
+		},
+		{
+			name: "unknown email",
+			run: func(t *testing.T, c *TowerClient, workspaceId string, memberId int64) {
+				participant, err := c.GetWorkspaceParticipantByMemberEmail(context.Background(), workspaceId, "tf-acceptance-unknown@example.com")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if participant != nil {
+					t.Fatalf("expected no participant, got %+v", *participant)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRecordedClient(t)
+			workspaceId := createTestWorkspace(t, c)
+			memberId := createTestOrganizationMember(t, c, "tf-acceptance-participant@example.com")
+			tt.run(t, c, workspaceId, memberId)
+		})
+	}
+}
@@ -27,45 +27,57 @@ func (c *TowerClient) CreateWorkspace(ctx context.Context, name string, fullName
 		return -1, fmt.Errorf("Empty response from server")
 	}
 
-	workspaceObj := res.(map[string]interface{})
-	workspace := workspaceObj["workspace"].(map[string]interface{})
+	var workspaceObj struct {
+		Workspace Workspace `json:"workspace"`
+	}
+
+	if err := decodeResponse(res, &workspaceObj); err != nil {
+		return -1, err
+	}
 
-	return int64(workspace["id"].(float64)), nil
+	return workspaceObj.Workspace.Id, nil
 }
 
-func (c *TowerClient) GetWorkspace(ctx context.Context, id int64) (map[string]interface{}, error) {
+func (c *TowerClient) GetWorkspace(ctx context.Context, id int64) (*Workspace, error) {
 	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/orgs/%d/workspaces/%d", c.orgId, id), nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	workspaceObj := res.(map[string]interface{})
-	workspace := workspaceObj["workspace"].(map[string]interface{})
+	var workspaceObj struct {
+		Workspace Workspace `json:"workspace"`
+	}
+
+	if err := decodeResponse(res, &workspaceObj); err != nil {
+		return nil, err
+	}
 
-	if strings.HasPrefix(workspace["name"].(string), "deleted-"){
+	if strings.HasPrefix(workspaceObj.Workspace.Name, "deleted-") {
 		return nil, nil
 	}
 
-	return workspace, nil
+	return &workspaceObj.Workspace, nil
 }
 
-func (c *TowerClient) GetWorkspaceByName(ctx context.Context, name string) (map[string]interface{}, error) {
-	res, err := c.requestWithoutPayload(ctx, "GET", fmt.Sprintf("/orgs/%d/workspaces", c.orgId), nil)
+// ListWorkspaces returns the workspaces of the organization.
+func (c *TowerClient) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
+	return listAll[Workspace](ctx, c, fmt.Sprintf("/orgs/%d/workspaces", c.orgId), nil, "workspaces", pageSize)
+}
 
-	if err != nil {
-		return nil, err
-	}
+func (c *TowerClient) GetWorkspaceByName(ctx context.Context, name string) (*Workspace, error) {
+	it := newPageIterator[Workspace](c, fmt.Sprintf("/orgs/%d/workspaces", c.orgId), nil, "workspaces", pageSize)
 
-	if workspaces, ok := res.(map[string]interface{}); ok {
-		for _, workspace := range workspaces["workspaces"].([]interface{}) {
-			o, _ := workspace.(map[string]interface{})
-			if o["name"].(string) == name {
-				return c.GetWorkspace(ctx, int64(o["id"].(float64)))
-			}
+	for it.Next(ctx) {
+		if o := it.Item(); o.Name == name {
+			return c.GetWorkspace(ctx, o.Id)
 		}
 	}
 
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
 	return nil, fmt.Errorf("Could not find a workspace with the name '%s'", name)
 }
 
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// createTestWorkspace creates a workspace for the current test, deleted when
+// the test finishes.
+func createTestWorkspace(t *testing.T, c *TowerClient) string {
+	t.Helper()
+
+	ctx := context.Background()
+
+	id, err := c.CreateWorkspace(ctx, "tf-acceptance-client-tests", "tf acceptance client tests", "Created by the client tests", "PRIVATE")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := c.DeleteWorkspace(ctx, id); err != nil {
+			t.Errorf("err: %s", err)
+		}
+	})
+
+	return fmt.Sprintf("%d", id)
+}
+
+func TestWorkspaces(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *TowerClient)
+	}{
+		{
+			name: "lifecycle",
+			run: func(t *testing.T, c *TowerClient) {
+				ctx := context.Background()
+
+				id, err := c.CreateWorkspace(ctx, "tf-acceptance-client-workspace", "tf acceptance client workspace", "A workspace", "PRIVATE")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				workspace, err := c.GetWorkspace(ctx, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				expected := Workspace{
+					Id:          id,
+					Name:        "tf-acceptance-client-workspace",
+					FullName:    "tf acceptance client workspace",
+					Description: "A workspace",
+					Visibility:  "PRIVATE",
+					DateCreated: workspace.DateCreated,
+					LastUpdated: workspace.LastUpdated,
+				}
+
+				if *workspace != expected {
+					t.Fatalf("expected %+v, got %+v", expected, *workspace)
+				}
+
+				if err := c.UpdateWorkspace(ctx, id, "tf acceptance client workspace updated", "An updated workspace", "SHARED"); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				workspace, err = c.GetWorkspaceByName(ctx, "tf-acceptance-client-workspace")
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if workspace.FullName != "tf acceptance client workspace updated" || workspace.Description != "An updated workspace" || workspace.Visibility != "SHARED" {
+					t.Fatalf("workspace was not updated: %+v", *workspace)
+				}
+
+				if err := c.DeleteWorkspace(ctx, id); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				// tower renames deleted workspaces rather than removing them
+				workspace, err = c.GetWorkspace(ctx, id)
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				if workspace != nil {
+					t.Fatalf("expected the deleted workspace to be nil, got %+v", *workspace)
+				}
+			},
+		},
+		{
+			name: "name conflict",
+			run: func(t *testing.T, c *TowerClient) {
+				createTestWorkspace(t, c)
+
+				_, err := c.CreateWorkspace(context.Background(), "tf-acceptance-client-tests", "", "", "PRIVATE")
+
+				if !IsConflict(err) {
+					t.Fatalf("expected a conflict, got %v", err)
+				}
+			},
+		},
+		{
+			name: "list",
+			run: func(t *testing.T, c *TowerClient) {
+				id := createTestWorkspace(t, c)
+
+				workspaces, err := c.ListWorkspaces(context.Background())
+				if err != nil {
+					t.Fatalf("err: %s", err)
+				}
+
+				for _, w := range workspaces {
+					if fmt.Sprintf("%d", w.Id) == id {
+						return
+					}
+				}
+
+				t.Fatalf("expected workspace %s in %+v", id, workspaces)
+			},
+		},
+		{
+			name: "unknown name",
+			run: func(t *testing.T, c *TowerClient) {
+				workspace, err := c.GetWorkspaceByName(context.Background(), "tf-acceptance-client-unknown")
+
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", workspace)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRecordedClient(t))
+		})
+	}
+}
@@ -2,6 +2,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/healx/terraform-provider-nftower/internal/client"
@@ -16,9 +19,18 @@ func dataSourceComputeEnv() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "The name of the environment. Only alphanumeric characters and dashes are allowed.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The name of the environment. Only alphanumeric characters and dashes are allowed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "primary"},
+			},
+			"primary": {
+				Description:  "Set to true to look up the primary compute environment of the workspace instead of looking it up by name.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "primary"},
 			},
 			"workspace_id": {
 				Type:        schema.TypeString,
@@ -51,7 +63,7 @@ func dataSourceComputeEnv() *schema.Resource {
 				Computed:    true,
 			},
 			"aws_batch": {
-				Description: "Configures an AWS Batch compute environment (manual only).",
+				Description: "Configures an AWS Batch compute environment.",
 				Type:        schema.TypeList,
 				Computed:    true,
 				Elem: &schema.Resource{
@@ -116,9 +128,434 @@ func dataSourceComputeEnv() *schema.Resource {
 							Description: "The number of MiB of memory reserved for the Nextflow runner job.",
 							Computed:    true,
 						},
+						"forge": {
+							Type:        schema.TypeList,
+							Description: "The Batch Forge settings when Tower created the Batch compute environments and queues.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "The provisioning model of the compute instances.",
+										Computed:    true,
+									},
+									"min_cpus": {
+										Type:        schema.TypeInt,
+										Description: "The minimum number of CPUs provisioned in the environment.",
+										Computed:    true,
+									},
+									"max_cpus": {
+										Type:        schema.TypeInt,
+										Description: "The maximum number of CPUs provisioned in the environment.",
+										Computed:    true,
+									},
+									"desired_cpus": {
+										Type:        schema.TypeInt,
+										Description: "The number of CPUs provisioned when the environment was created.",
+										Computed:    true,
+									},
+									"instance_types": {
+										Type:        schema.TypeList,
+										Description: "The instance types or families which can be used for the compute jobs.",
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"vpc_id": {
+										Type:        schema.TypeString,
+										Description: "The VPC in which the compute instances are launched.",
+										Computed:    true,
+									},
+									"subnets": {
+										Type:        schema.TypeList,
+										Description: "The subnets of the VPC in which the compute instances are launched.",
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"security_groups": {
+										Type:        schema.TypeList,
+										Description: "The security groups attached to the compute instances.",
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"ebs_auto_scale": {
+										Type:        schema.TypeBool,
+										Description: "Whether the EBS volume of the compute instances grows automatically.",
+										Computed:    true,
+									},
+									"ebs_block_size": {
+										Type:        schema.TypeInt,
+										Description: "The initial size in GB of the EBS auto-expandable volume.",
+										Computed:    true,
+									},
+									"fsx_name": {
+										Type:        schema.TypeString,
+										Description: "The DNS name of the mounted FSx for Lustre file system.",
+										Computed:    true,
+									},
+									"fsx_mount": {
+										Type:        schema.TypeString,
+										Description: "The path where the FSx for Lustre file system is mounted.",
+										Computed:    true,
+									},
+									"fsx_size": {
+										Type:        schema.TypeInt,
+										Description: "The size in GB of the FSx for Lustre file system.",
+										Computed:    true,
+									},
+									"efs_id": {
+										Type:        schema.TypeString,
+										Description: "The id of the mounted EFS file system.",
+										Computed:    true,
+									},
+									"efs_create": {
+										Type:        schema.TypeBool,
+										Description: "Whether Tower created an EFS file system for the environment.",
+										Computed:    true,
+									},
+									"efs_mount": {
+										Type:        schema.TypeString,
+										Description: "The path where the EFS file system is mounted.",
+										Computed:    true,
+									},
+									"fusion2_enabled": {
+										Type:        schema.TypeBool,
+										Description: "Whether Fusion v2 is enabled.",
+										Computed:    true,
+									},
+									"wave_enabled": {
+										Type:        schema.TypeBool,
+										Description: "Whether the Wave containers service is enabled.",
+										Computed:    true,
+									},
+									"gpu_enabled": {
+										Type:        schema.TypeBool,
+										Description: "Whether GPU enabled instances are used for the compute jobs.",
+										Computed:    true,
+									},
+									"allow_buckets": {
+										Type:        schema.TypeList,
+										Description: "The additional S3 buckets the compute instances are granted access to.",
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"dispose_on_deletion": {
+										Type:        schema.TypeBool,
+										Description: "Whether the AWS resources created by Tower are deleted with the compute environment.",
+										Computed:    true,
+									},
+									"ec2_key_pair": {
+										Type:        schema.TypeString,
+										Description: "The name of the EC2 key pair used to access the compute instances.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"lsf_platform": {
+				Description: "Configures an IBM LSF compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCDataSourceSchema("IBM LSF", "BSUB", map[string]*schema.Schema{
+						"per_job_mem_limit": {
+							Type:        schema.TypeBool,
+							Description: "Enable per-job mem limits.",
+							Computed:    true,
+						},
+						"per_task_reserve": {
+							Type:        schema.TypeBool,
+							Description: "Enable per task reserve.",
+							Computed:    true,
+						},
+						"unit_for_limits": {
+							Type:        schema.TypeString,
+							Description: "the unit to use for limits.",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"slurm_platform": {
+				Description: "Configures a Slurm compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCDataSourceSchema("Slurm", "sbatch", nil),
+				},
+			},
+			"altair_platform": {
+				Description: "Configures an Altair PBS Pro compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCDataSourceSchema("Altair PBS Pro", "qsub", nil),
+				},
+			},
+			"uge_platform": {
+				Description: "Configures a Grid Engine (SGE/UGE) compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCDataSourceSchema("Grid Engine", "qsub", nil),
+				},
+			},
+			"moab_platform": {
+				Description: "Configures a Moab compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCDataSourceSchema("Moab", "msub", nil),
+				},
+			},
+			"google_batch": {
+				Description: "Configures a Google Batch compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:        schema.TypeString,
+							Description: "The Google Cloud location (region) where the jobs are executed e.g. europe-west2.",
+							Computed:    true,
+						},
+						"work_dir": {
+							Type:        schema.TypeString,
+							Description: "A Google Cloud Storage bucket path e.g. gs://my-bucket/work.",
+							Computed:    true,
+						},
+						"spot": {
+							Type:        schema.TypeBool,
+							Description: "Whether spot virtual machines are used for the compute jobs.",
+							Computed:    true,
+						},
+						"use_private_address": {
+							Type:        schema.TypeBool,
+							Description: "Whether the virtual machines have no public IP address.",
+							Computed:    true,
+						},
+						"boot_disk_size_gb": {
+							Type:        schema.TypeInt,
+							Description: "The size of the boot disk of the virtual machines in GB.",
+							Computed:    true,
+						},
+						"cpu_platform": {
+							Type:        schema.TypeString,
+							Description: "The minimum CPU platform of the virtual machines.",
+							Computed:    true,
+						},
+						"machine_types": {
+							Type:        schema.TypeList,
+							Description: "The machine types or families which can be used for the compute jobs.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Description: "The VPC network the virtual machines are attached to.",
+							Computed:    true,
+						},
+						"subnetwork": {
+							Type:        schema.TypeString,
+							Description: "The subnetwork the virtual machines are attached to.",
+							Computed:    true,
+						},
+						"service_account": {
+							Type:        schema.TypeString,
+							Description: "The email of the service account used by the virtual machines.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "The labels applied to the Google Batch jobs and the resources they create.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"pre_run_script": {
+							Type:        schema.TypeString,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
+							Computed:    true,
+						},
+						"post_run_script": {
+							Type:        schema.TypeString,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
+							Computed:    true,
+						},
+						"head_job_cpus": {
+							Type:        schema.TypeInt,
+							Description: "The number of CPUs to be allocated for the Nextflow runner job.",
+							Computed:    true,
+						},
+						"head_job_memory_mb": {
+							Type:        schema.TypeInt,
+							Description: "The number of MiB of memory reserved for the Nextflow runner job.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"azure_batch": {
+				Description: "Configures an Azure Batch compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The Azure region where the Batch account lives.",
+							Computed:    true,
+						},
+						"work_dir": {
+							Type:        schema.TypeString,
+							Description: "An Azure Blob Storage container path e.g. az://my-container/work.",
+							Computed:    true,
+						},
+						"head_pool": {
+							Type:        schema.TypeString,
+							Description: "The name of the Batch pool that runs the Nextflow application.",
+							Computed:    true,
+						},
+						"auto_pool_mode": {
+							Type:        schema.TypeBool,
+							Description: "Whether Nextflow creates a Batch pool on demand for each compute job.",
+							Computed:    true,
+						},
+						"forge": {
+							Type:        schema.TypeList,
+							Description: "The Batch Forge settings when Tower created the Batch pools.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vm_type": {
+										Type:        schema.TypeString,
+										Description: "The virtual machine size of the pool nodes.",
+										Computed:    true,
+									},
+									"vm_count": {
+										Type:        schema.TypeInt,
+										Description: "The number of virtual machines in the pool.",
+										Computed:    true,
+									},
+									"auto_scale": {
+										Type:        schema.TypeBool,
+										Description: "Whether the pool scales automatically.",
+										Computed:    true,
+									},
+									"dispose_on_deletion": {
+										Type:        schema.TypeBool,
+										Description: "Whether the Batch pool is deleted with the compute environment.",
+										Computed:    true,
+									},
+									"container_registry_ids": {
+										Type:        schema.TypeList,
+										Description: "The container registry credentials ids available to the pool.",
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"delete_jobs_on_completion": {
+							Type:        schema.TypeString,
+							Description: "When the Batch jobs created by a workflow are deleted.",
+							Computed:    true,
+						},
+						"delete_pools_on_completion": {
+							Type:        schema.TypeBool,
+							Description: "Whether pools created in auto-pool mode are deleted when the workflow completes.",
+							Computed:    true,
+						},
+						"token_duration": {
+							Type:        schema.TypeString,
+							Description: "The duration of the SAS token generated by Nextflow.",
+							Computed:    true,
+						},
+						"pre_run_script": {
+							Type:        schema.TypeString,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
+							Computed:    true,
+						},
+						"post_run_script": {
+							Type:        schema.TypeString,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
+							Computed:    true,
+						},
 					},
 				},
 			},
+			"k8s_platform": {
+				Description: "Configures a Kubernetes compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentKubernetesDataSourceSchema(map[string]*schema.Schema{
+						"server": {
+							Type:        schema.TypeString,
+							Description: "The URL of the Kubernetes API server.",
+							Computed:    true,
+						},
+						"ssl_cert": {
+							Type:        schema.TypeString,
+							Description: "The PEM encoded CA certificate used to verify the Kubernetes API server.",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"eks_platform": {
+				Description: "Configures an Amazon EKS compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentKubernetesDataSourceSchema(map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The AWS region where the EKS cluster lives.",
+							Computed:    true,
+						},
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the EKS cluster.",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"gke_platform": {
+				Description: "Configures a Google Kubernetes Engine compute environment.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentKubernetesDataSourceSchema(map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The Google Cloud region or zone where the GKE cluster lives.",
+							Computed:    true,
+						},
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the GKE cluster.",
+							Computed:    true,
+						},
+					}),
+				},
+			},
 			"environment_variable": {
 				Type:        schema.TypeList,
 				Description: "A List of environment variables that can be included for head or compute jobs.",
@@ -147,41 +584,224 @@ func dataSourceComputeEnv() *schema.Resource {
 	}
 }
 
-func dataSourceComputeEnvRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	towerClient := meta.(*client.TowerClient)
+// computeEnvironmentHPCDataSourceSchema returns the settings shared by the HPC
+// scheduler platforms, merged with the platform specific ones.
+func computeEnvironmentHPCDataSourceSchema(scheduler string, submitCommand string, platformSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"work_dir": {
+			Type:        schema.TypeString,
+			Description: "The nextflow work directory.",
+			Computed:    true,
+		},
+		"launch_dir": {
+			Type:        schema.TypeString,
+			Description: "The directory where tower will launch workflows.",
+			Computed:    true,
+		},
+		"user_name": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("%s username to use.", scheduler),
+			Computed:    true,
+		},
+		"host_name": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("hostname of the login node for your %s cluster.", scheduler),
+			Computed:    true,
+		},
+		"head_queue": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The %s queue that will run the Nextflow application.", scheduler),
+			Computed:    true,
+		},
+		"compute_queue": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The default %s queue to which Nextflow will submit job executions.", scheduler),
+			Computed:    true,
+		},
+		"head_job_options": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("options to add to %s when submitting the head job.", submitCommand),
+			Computed:    true,
+		},
+		"propagate_head_job_options": {
+			Type:        schema.TypeBool,
+			Description: "Whether to propagate the head job optoins to spawned worker jobs or not.",
+			Computed:    true,
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "The port for ssh connection.",
+			Computed:    true,
+		},
+		"max_queue_size": {
+			Type:        schema.TypeInt,
+			Description: "Max size of queue.",
+			Computed:    true,
+		},
+		"pre_run_script": {
+			Type:        schema.TypeString,
+			Description: "script to run on submission node before running nextflow.",
+			Computed:    true,
+		},
+		"post_run_script": {
+			Type:        schema.TypeString,
+			Description: "script to run on submission node after running nextflow.",
+			Computed:    true,
+		},
+	}
 
-	computeEnv, err := towerClient.GetComputeEnvByName(ctx, d.Get("workspace_id").(string), d.Get("name").(string))
+	for k, v := range platformSchema {
+		s[k] = v
+	}
+
+	return s
+}
 
-	if err != nil {
-		return diag.FromErr(err)
+// computeEnvironmentKubernetesDataSourceSchema returns the settings shared by
+// the Kubernetes based platforms, merged with the platform specific ones.
+func computeEnvironmentKubernetesDataSourceSchema(platformSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"work_dir": {
+			Type:        schema.TypeString,
+			Description: "The Nextflow work directory.",
+			Computed:    true,
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "The Kubernetes namespace in which the pods are launched.",
+			Computed:    true,
+		},
+		"head_service_account": {
+			Type:        schema.TypeString,
+			Description: "The Kubernetes service account used by the Nextflow head pod.",
+			Computed:    true,
+		},
+		"compute_service_account": {
+			Type:        schema.TypeString,
+			Description: "The Kubernetes service account used by the Nextflow compute pods.",
+			Computed:    true,
+		},
+		"storage_claim_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the persistent volume claim used as the Nextflow scratch storage.",
+			Computed:    true,
+		},
+		"storage_mount_path": {
+			Type:        schema.TypeString,
+			Description: "The path where the persistent volume claim is mounted in the pods.",
+			Computed:    true,
+		},
+		"pod_cleanup": {
+			Type:        schema.TypeString,
+			Description: "When the pods created by a workflow are deleted.",
+			Computed:    true,
+		},
+		"head_pod_spec": {
+			Type:        schema.TypeString,
+			Description: "The custom YAML pod specification applied to the Nextflow head pod.",
+			Computed:    true,
+		},
+		"service_pod_spec": {
+			Type:        schema.TypeString,
+			Description: "The custom YAML pod specification applied to the Tower service pods.",
+			Computed:    true,
+		},
+		"pre_run_script": {
+			Type:        schema.TypeString,
+			Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
+			Computed:    true,
+		},
+		"post_run_script": {
+			Type:        schema.TypeString,
+			Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
+			Computed:    true,
+		},
 	}
 
-	if computeEnv == nil {
-		return diag.Errorf("unable to find compute environment with name: %s", d.Get("name").(string))
+	for k, v := range platformSchema {
+		s[k] = v
 	}
 
-	d.SetId(computeEnv["id"].(string))
+	return s
+}
+
+func dataSourceComputeEnvRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	towerClient := meta.(*client.TowerClient)
+
+	var computeEnv *client.ComputeEnv
+	var err error
+
+	if d.Get("primary").(bool) {
+		computeEnv, err = towerClient.GetPrimaryComputeEnv(ctx, d.Get("workspace_id").(string))
 
-	d.Set("name", computeEnv["name"].(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	if description, ok := computeEnv["description"].(string); ok {
-		d.Set("description", description)
+		if computeEnv == nil {
+			return diag.Errorf("unable to find a primary compute environment in workspace: %s", d.Get("workspace_id").(string))
+		}
 	} else {
-		d.Set("description", nil)
+		computeEnv, err = towerClient.GetComputeEnvByName(ctx, d.Get("workspace_id").(string), d.Get("name").(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if computeEnv == nil {
+			return diag.Errorf("unable to find compute environment with name: %s", d.Get("name").(string))
+		}
 	}
 
-	d.Set("credentials_id", computeEnv["credentialsId"].(string))
-	d.Set("date_created", computeEnv["dateCreated"].(string))
-	d.Set("last_updated", computeEnv["lastUpdated"].(string))
-	d.Set("status", computeEnv["status"].(string))
+	d.SetId(computeEnv.Id)
+
+	d.Set("name", computeEnv.Name)
+
+	d.Set("description", computeEnv.Description)
 
-	switch computeEnv["platform"].(string) {
+	d.Set("credentials_id", computeEnv.CredentialsId)
+	d.Set("date_created", computeEnv.DateCreated)
+	d.Set("last_updated", computeEnv.LastUpdated)
+	d.Set("status", computeEnv.Status)
+
+	d.Set("primary", computeEnv.Primary)
+
+	switch computeEnv.Platform {
 	case "aws-batch":
-		config := computeEnv["config"].(client.ComputeEnvAWSBatchConfig)
+		config := computeEnv.Config.(client.ComputeEnvAWSBatchConfig)
 		d.Set("aws_batch", flattenComputeEnvironmentAWSBatch(ctx, &config))
 		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "lsf-platform":
+		config := computeEnv.Config.(client.ComputeEnvLSFPlatformConfig)
+		d.Set("lsf_platform", flattenComputeEnvironmentLSFPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "slurm-platform", "altair-platform", "uge-platform", "moab-platform":
+		platform := computeEnv.Platform
+		config := computeEnv.Config.(client.ComputeEnvHPCPlatformConfig)
+		d.Set(strings.Replace(platform, "-", "_", 1), flattenComputeEnvironmentHPCPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "google-batch":
+		config := computeEnv.Config.(client.ComputeEnvGoogleBatchConfig)
+		d.Set("google_batch", flattenComputeEnvironmentGoogleBatch(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "azure-batch":
+		config := computeEnv.Config.(client.ComputeEnvAzureBatchConfig)
+		d.Set("azure_batch", flattenComputeEnvironmentAzureBatch(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "k8s-platform":
+		config := computeEnv.Config.(client.ComputeEnvK8sPlatformConfig)
+		d.Set("k8s_platform", flattenComputeEnvironmentK8sPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "eks-platform":
+		config := computeEnv.Config.(client.ComputeEnvEKSPlatformConfig)
+		d.Set("eks_platform", flattenComputeEnvironmentEKSPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "gke-platform":
+		config := computeEnv.Config.(client.ComputeEnvGKEPlatformConfig)
+		d.Set("gke_platform", flattenComputeEnvironmentGKEPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
 	default:
-		return diag.Errorf("unsupported platform type: %s", computeEnv["platform"].(string))
+		return diag.Errorf("unsupported platform type: %s", computeEnv.Platform)
 	}
 
 	return nil
@@ -117,6 +117,151 @@ func dataSourceCredentials() *schema.Resource {
 					},
 				},
 			},
+			"bitbucket": {
+				Description: "Stores a bitbucket app password.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The name of the user that the app password belongs.",
+							Computed:    true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url when connecting to bitbucket. Used for bitbucket server on-prem.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"gitea": {
+				Description: "Stores a gitea password.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The name of the gitea user.",
+							Computed:    true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url of the gitea server e.g. https://gitea.example.com.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"azure_repos": {
+				Description: "Stores an Azure Repos personal access token.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The name of the user that the token belongs.",
+							Computed:    true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url when connecting to Azure Repos e.g. https://dev.azure.com/my-org.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"codecommit": {
+				Description: "Stores an AWS IAM access key for AWS CodeCommit.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": {
+							Type:        schema.TypeString,
+							Description: "The AWS access key.",
+							Computed:    true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url of the CodeCommit repositories e.g. https://git-codecommit.eu-west-1.amazonaws.com.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"azure": {
+				Description: "Stores Azure Batch and Storage credentials.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the Azure Batch account.",
+							Computed:    true,
+						},
+						"storage_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the Azure Storage account.",
+							Computed:    true,
+						},
+						"tenant_id": {
+							Type:        schema.TypeString,
+							Description: "The Entra tenant id of the service principal, when not using shared keys.",
+							Computed:    true,
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Description: "The client id of the Entra service principal, when not using shared keys.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"kubernetes": {
+				Description: "Stores a Kubernetes service account token or client certificate. The secrets are never returned by tower.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
+			"tower_agent": {
+				Description: "Stores the connection of a Tower Agent.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:        schema.TypeString,
+							Description: "The connection id the agent was started with.",
+							Computed:    true,
+						},
+						"work_dir": {
+							Type:        schema.TypeString,
+							Description: "The default work directory of the agent.",
+							Computed:    true,
+						},
+						"shared": {
+							Type:        schema.TypeBool,
+							Description: "Whether the agent is shared by all the users of the workspace.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"google": {
+				Description: "Stores a Google Cloud service account key. The key itself is never returned by tower.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
 		},
 	}
 }
@@ -134,80 +279,72 @@ func dataSourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to find credentials with name: %s", d.Get("name").(string))
 	}
 
-	d.SetId(credentials["id"].(string))
-	d.Set("name", credentials["name"].(string))
-
-	if v, ok := credentials["description"].(string); ok {
-		d.Set("description", v)
-	}
-
-	d.Set("date_created", credentials["dateCreated"].(string))
-	d.Set("last_updated", credentials["lastUpdated"].(string))
+	d.SetId(credentials.Id)
+	d.Set("name", credentials.Name)
+	d.Set("description", credentials.Description)
+	d.Set("date_created", credentials.DateCreated)
+	d.Set("last_updated", credentials.LastUpdated)
 
-	keys := credentials["keys"].(map[string]interface{})
-	switch credentials["provider"].(string) {
+	keys := credentials.Keys
+	switch credentials.Provider {
 	case "aws":
-		if assumeRoleArn, ok := keys["assumeRoleArn"].(string); ok {
-			d.Set("aws", []interface{}{
-				map[string]interface{}{
-					"access_key":      keys["accessKey"].(string),
-					"assume_role_arn": assumeRoleArn,
-				},
-			})
-		} else {
-			d.Set("aws", []interface{}{
-				map[string]interface{}{
-					"access_key": keys["accessKey"].(string),
-				},
-			})
-		}
+		d.Set("aws", []interface{}{
+			map[string]interface{}{
+				"access_key":      keys.AccessKey,
+				"assume_role_arn": keys.AssumeRoleArn,
+			},
+		})
 	case "container-reg":
-		if registry, ok := keys["registry"].(string); ok {
-			d.Set("container_registry", []interface{}{
-				map[string]interface{}{
-					"username":        keys["userName"].(string),
-					"registry_server": registry,
-				},
-			})
-		} else {
-			d.Set("container_registry", []interface{}{
-				map[string]interface{}{
-					"username": keys["userName"].(string),
-				},
-			})
-		}
-	case "github":
-		if baseUrl, ok := credentials["baseUrl"].(string); ok {
-			d.Set("github", []interface{}{
-				map[string]interface{}{
-					"username": keys["username"].(string),
-					"base_url": baseUrl,
-				},
-			})
-		} else {
-			d.Set("github", []interface{}{
-				map[string]interface{}{
-					"username": keys["username"].(string),
-				},
-			})
-		}
-	case "gitlab":
-		if baseUrl, ok := credentials["baseUrl"].(string); ok {
-			d.Set("gitlab", []interface{}{
-				map[string]interface{}{
-					"username": keys["username"].(string),
-					"base_url": baseUrl,
-				},
-			})
-		} else {
-			d.Set("gitlab", []interface{}{
-				map[string]interface{}{
-					"username": keys["username"].(string),
-				},
-			})
-		}
+		d.Set("container_registry", []interface{}{
+			map[string]interface{}{
+				"username":        keys.Username,
+				"registry_server": keys.Registry,
+			},
+		})
+	case "github", "gitlab", "bitbucket", "gitea":
+		d.Set(credentials.Provider, []interface{}{
+			map[string]interface{}{
+				"username": keys.Username,
+				"base_url": credentials.BaseUrl,
+			},
+		})
+	case "azurerepos":
+		d.Set("azure_repos", []interface{}{
+			map[string]interface{}{
+				"username": keys.Username,
+				"base_url": credentials.BaseUrl,
+			},
+		})
+	case "codecommit":
+		d.Set("codecommit", []interface{}{
+			map[string]interface{}{
+				"access_key": keys.Username,
+				"base_url":   credentials.BaseUrl,
+			},
+		})
+	case "azure", "azure_entra":
+		d.Set("azure", []interface{}{
+			map[string]interface{}{
+				"batch_name":   keys.BatchName,
+				"storage_name": keys.StorageName,
+				"tenant_id":    keys.TenantId,
+				"client_id":    keys.ClientId,
+			},
+		})
+	case "k8s":
+		d.Set("kubernetes", []interface{}{
+			map[string]interface{}{},
+		})
+	case "tw-agent":
+		d.Set("tower_agent", []interface{}{
+			flattenCredentialsTowerAgent(keys),
+		})
+	case "google":
+		d.Set("google", []interface{}{
+			map[string]interface{}{},
+		})
 	default:
-		return diag.Errorf("unsupported credentials type %s", credentials["provider"].(string))
+		return diag.Errorf("unsupported credentials type %s", credentials.Provider)
 	}
 
 	return nil
@@ -60,17 +60,13 @@ func dataSourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("unable to find member with email: %s", email)
 	}
 
-	d.SetId(fmt.Sprintf("%d", int64(member["memberId"].(float64))))
+	d.SetId(fmt.Sprintf("%d", member.MemberId))
 
-	if v, ok := member["firstName"].(string); ok {
-		d.Set("first_name", v)
-	}
-	if v, ok := member["lastName"].(string); ok {
-		d.Set("last_name", v)
-	}
+	d.Set("first_name", member.FirstName)
+	d.Set("last_name", member.LastName)
 
-	d.Set("role", member["role"].(string))
-	d.Set("user_name", member["userName"].(string))
+	d.Set("role", member.Role)
+	d.Set("user_name", member.UserName)
 
 	return nil
 }
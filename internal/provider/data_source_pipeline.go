@@ -124,58 +124,11 @@ func dataSourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.Errorf("unable to find pipeline with name: %s", d.Get("name").(string))
 	}
 
-	d.SetId(fmt.Sprintf("%d", int64(pipeline["pipelineId"].(float64))))
+	d.SetId(fmt.Sprintf("%d", pipeline.PipelineId))
 
-	d.Set("name", pipeline["name"].(string))
-	d.Set("pipeline", pipeline["pipeline"].(string))
-	d.Set("work_dir", pipeline["workDir"].(string))
+	d.Set("name", pipeline.Name)
 
-	computeEnv := pipeline["computeEnv"].(map[string]interface{})
-	d.Set("compute_environment_id", computeEnv["id"].(string))
-
-	if v, ok := pipeline["revision"].(string); ok {
-		d.Set("revision", v)
-	}
-
-	if v, ok := pipeline["preRunScript"].(string); ok {
-		d.Set("pre_run_script", v)
-	}
-
-	if v, ok := pipeline["postRunScript"].(string); ok {
-		d.Set("post_run_script", v)
-	}
-
-	if v, ok := pipeline["configProfiles"].([]interface{}); ok {
-		d.Set("config_profiles", v)
-	}
-
-	if v, ok := pipeline["paramsText"].(string); ok {
-		d.Set("pipeline_parameters", v)
-	}
-
-	if v, ok := pipeline["configText"].(string); ok {
-		d.Set("nextflow_config", v)
-	}
-
-	if v, ok := pipeline["towerConfig"].(string); ok {
-		d.Set("tower_config", v)
-	}
-
-	if v, ok := pipeline["mainScript"].(string); ok {
-		d.Set("main_script", v)
-	}
-
-	if v, ok := pipeline["entryName"].(string); ok {
-		d.Set("workflow_entry_name", v)
-	}
-
-	if v, ok := pipeline["schema_name"].(string); ok {
-		d.Set("schema_name", v)
-	}
-
-	if v, ok := pipeline["workspaceSecrets"].([]interface{}); ok {
-		d.Set("workspace_secrets", v)
-	}
+	setLaunchAttributes(d, pipeline.Launch)
 
 	return nil
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,13 +44,13 @@ func dataSourcePipelineSecretsRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("unable to find pipeline-secret with name: %s", d.Get("name").(string))
 	}
 
-	d.SetId(pipelineSecret["id"].(string))
-	d.Set("name", pipelineSecret["name"].(string))
+	d.SetId(fmt.Sprintf("%d", pipelineSecret.Id))
+	d.Set("name", pipelineSecret.Name)
 
-	d.Set("date_used", pipelineSecret["lastUsed"].(string))
+	d.Set("date_used", pipelineSecret.LastUsed)
 
-	d.Set("date_created", pipelineSecret["dateCreated"].(string))
-	d.Set("last_updated", pipelineSecret["lastUpdated"].(string))
+	d.Set("date_created", pipelineSecret.DateCreated)
+	d.Set("last_updated", pipelineSecret.LastUpdated)
 
 	return nil
 }
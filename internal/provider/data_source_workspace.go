@@ -64,20 +64,14 @@ func dataSourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta a
 		return diag.Errorf("unable to find workspace with name: %s", d.Get("name").(string))
 	}
 
-	d.SetId(fmt.Sprintf("%d", int64(workspace["id"].(float64))))
+	d.SetId(fmt.Sprintf("%d", workspace.Id))
 
-	d.Set("name", workspace["name"].(string))
-	d.Set("full_name", workspace["fullName"].(string))
-
-	if description, ok := workspace["description"].(string); ok {
-		d.Set("description", description)
-	} else {
-		d.Set("description", nil)
-	}
-
-	d.Set("visibility", workspace["visibility"].(string))
-	d.Set("date_created", workspace["dateCreated"].(string))
-	d.Set("last_updated", workspace["lastUpdated"].(string))
+	d.Set("name", workspace.Name)
+	d.Set("full_name", workspace.FullName)
+	d.Set("description", workspace.Description)
+	d.Set("visibility", workspace.Visibility)
+	d.Set("date_created", workspace.DateCreated)
+	d.Set("last_updated", workspace.LastUpdated)
 
 	return nil
 }
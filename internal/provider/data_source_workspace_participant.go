@@ -68,17 +68,13 @@ func dataSourceWorkspaceParticipantRead(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("unable to find participant with email: %s", email)
 	}
 
-	d.SetId(fmt.Sprintf("%d", int64(participant["participantId"].(float64))))
+	d.SetId(fmt.Sprintf("%d", participant.ParticipantId))
 
-	if v, ok := participant["firstName"].(string); ok {
-		d.Set("first_name", v)
-	}
-	if v, ok := participant["lastName"].(string); ok {
-		d.Set("last_name", v)
-	}
+	d.Set("first_name", participant.FirstName)
+	d.Set("last_name", participant.LastName)
 
-	d.Set("role", participant["wspRole"].(string))
-	d.Set("member_id", fmt.Sprintf("%d", int64(participant["memberId"].(float64))))
+	d.Set("role", participant.WspRole)
+	d.Set("member_id", fmt.Sprintf("%d", participant.MemberId))
 
 	return nil
 }
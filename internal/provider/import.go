@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceImportWorkspaceScoped imports resources whose ids are only unique
+// within a workspace, using an import id with the format workspace_id/id.
+func resourceImportWorkspaceScoped(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	workspaceId, id, err := resourceParseWorkspaceScopedId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("workspace_id", workspaceId)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceImportIntegerId imports resources whose ids are tower integer ids.
+func resourceImportIntegerId(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, err := resourceParseIntegerId(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceImportWorkspaceScopedIntegerId imports resources whose ids are tower
+// integer ids only unique within a workspace, using an import id with the
+// format workspace_id/id.
+func resourceImportWorkspaceScopedIntegerId(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	_, id, err := resourceParseWorkspaceScopedId(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := resourceParseIntegerId(id); err != nil {
+		return nil, err
+	}
+
+	return resourceImportWorkspaceScoped(ctx, d, meta)
+}
+
+func resourceParseIntegerId(id string) (int64, error) {
+	parsed, err := strconv.ParseInt(id, 10, 64)
+
+	if err != nil {
+		return -1, fmt.Errorf("Expected id to be an integer, got %v", id)
+	}
+
+	return parsed, nil
+}
+
+func resourceParseWorkspaceScopedId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Expected identifier with format: workspace_id/id. Got: %v", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/healx/terraform-provider-nftower/internal/client"
+)
+
+func TestResourceImportIntegerId(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		err  bool
+	}{
+		{
+			name: "integer id",
+			id:   "123",
+		},
+		{
+			name: "not an integer",
+			id:   "foo",
+			err:  true,
+		},
+		{
+			name: "empty",
+			id:   "",
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceOrganizationMember().TestResourceData()
+			d.SetId(tt.id)
+
+			_, err := resourceImportIntegerId(context.Background(), d, nil)
+
+			if tt.err != (err != nil) {
+				t.Fatalf("expected error %t, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestResourceImportWorkspaceScopedIntegerId(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		workspaceId string
+		participant string
+		err         bool
+	}{
+		{
+			name:        "integer id",
+			id:          "456/123",
+			workspaceId: "456",
+			participant: "123",
+		},
+		{
+			name: "not an integer",
+			id:   "456/foo",
+			err:  true,
+		},
+		{
+			name: "no workspace",
+			id:   "123",
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceWorkspaceParticipant().TestResourceData()
+			d.SetId(tt.id)
+
+			_, err := resourceImportWorkspaceScopedIntegerId(context.Background(), d, nil)
+
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if d.Get("workspace_id") != tt.workspaceId {
+				t.Errorf("expected workspace_id %s, got %s", tt.workspaceId, d.Get("workspace_id"))
+			}
+
+			if d.Id() != tt.participant {
+				t.Errorf("expected id %s, got %s", tt.participant, d.Id())
+			}
+		})
+	}
+}
+
+func TestResourceOrganizationMemberReadInvalidId(t *testing.T) {
+	d := resourceOrganizationMember().TestResourceData()
+	d.SetId("foo")
+
+	// the id is parsed before tower is called
+	diags := resourceOrganizationMemberRead(context.Background(), d, (*client.TowerClient)(nil))
+
+	if !diags.HasError() {
+		t.Fatal("expected an error reading a member with a non integer id")
+	}
+}
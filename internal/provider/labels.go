@@ -2,13 +2,13 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/healx/terraform-provider-nftower/internal/client"
 )
 
-func flattenLabels(labels []interface{}) []interface{} {
+func flattenLabels(labels []client.Label) []interface{} {
 	res := []interface{}{}
-	for _, label := range labels {
-		l, _ := label.(map[string]interface{})
-		res = append(res, l["name"].(string))
+	for _, l := range labels {
+		res = append(res, l.Name)
 	}
 
 	return res
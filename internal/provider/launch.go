@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/healx/terraform-provider-nftower/internal/client"
+)
+
+// setLaunchAttributes sets the launch settings shared by pipelines and
+// actions.
+func setLaunchAttributes(d *schema.ResourceData, launch *client.Launch) {
+	if launch == nil {
+		return
+	}
+
+	d.Set("pipeline", launch.Pipeline)
+	d.Set("work_dir", launch.WorkDir)
+	d.Set("compute_environment_id", launch.ComputeEnvId())
+	d.Set("revision", launch.Revision)
+	d.Set("pre_run_script", launch.PreRunScript)
+	d.Set("post_run_script", launch.PostRunScript)
+	d.Set("config_profiles", launch.ConfigProfiles)
+	d.Set("pipeline_parameters", launch.ParamsText)
+	d.Set("nextflow_config", launch.ConfigText)
+	d.Set("tower_config", launch.TowerConfig)
+	d.Set("main_script", launch.MainScript)
+	d.Set("workflow_entry_name", launch.EntryName)
+	d.Set("schema_name", launch.SchemaName)
+	d.Set("workspace_secrets", launch.WorkspaceSecrets)
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/healx/terraform-provider-nftower/internal/client"
 )
 
@@ -37,6 +39,68 @@ func New(version string) func() *schema.Provider {
 						"NFTOWER_ORGANIZATION",
 					}, nil),
 				},
+				"max_retries": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Number of times a failed request to tower is retried. Requests creating objects are only retried when tower rejected them with a 429.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_MAX_RETRIES",
+					}, 4),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_wait_min": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Minimum number of seconds to wait before retrying a request, unless tower asks for a longer wait with a Retry-After header.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_RETRY_WAIT_MIN",
+					}, 1),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_wait_max": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Maximum number of seconds to wait before retrying a request, unless tower asks for a longer wait with a Retry-After header.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_RETRY_WAIT_MAX",
+					}, 30),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"request_timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Number of seconds after which a request to tower times out, 0 for no timeout.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_REQUEST_TIMEOUT",
+					}, 60),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"requests_per_second": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Description: "Maximum number of requests per second sent to tower, lower it when tower rate limits the provider.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_REQUESTS_PER_SECOND",
+					}, 20),
+				},
+				"burst": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Number of requests which can be sent to tower at once, above `requests_per_second`.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_BURST",
+					}, 20),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"list_cache_ttl": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Number of seconds for which the objects listed from tower are cached to look up objects by name, 0 to disable the cache. Writes made by the provider drop the cached objects they affect.",
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+						"NFTOWER_LIST_CACHE_TTL",
+					}, 60),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"nftower_workspace":             dataSourceWorkspace(),
@@ -70,11 +134,35 @@ func New(version string) func() *schema.Provider {
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
-		c, err := client.NewTowerClient(ctx,
+		opts := client.DefaultOptions()
+		opts.MaxRetries = d.Get("max_retries").(int)
+		opts.RetryWaitMin = time.Duration(d.Get("retry_wait_min").(int)) * time.Second
+		opts.RetryWaitMax = time.Duration(d.Get("retry_wait_max").(int)) * time.Second
+		opts.RequestTimeout = time.Duration(d.Get("request_timeout").(int)) * time.Second
+		opts.RequestsPerSecond = d.Get("requests_per_second").(float64)
+		opts.Burst = d.Get("burst").(int)
+		opts.ListCacheTTL = time.Duration(d.Get("list_cache_ttl").(int)) * time.Second
+
+		if opts.RetryWaitMin > opts.RetryWaitMax {
+			return nil, diag.Errorf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", opts.RetryWaitMin, opts.RetryWaitMax)
+		}
+
+		// the defaults read from the environment are not validated by the
+		// schema
+		if opts.RequestsPerSecond <= 0 {
+			return nil, diag.Errorf("requests_per_second (%g) must be greater than 0", opts.RequestsPerSecond)
+		}
+
+		if opts.Burst < 1 {
+			return nil, diag.Errorf("burst (%d) must be at least 1", opts.Burst)
+		}
+
+		c, err := client.NewTowerClientWithOptions(ctx,
 			p.UserAgent("terraform-provider-nftower", version),
 			d.Get("api_key").(string),
 			d.Get("api_url").(string),
-			d.Get("organization").(string))
+			d.Get("organization").(string),
+			opts)
 
 		if err != nil {
 			return nil, diag.FromErr(err)
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/healx/terraform-provider-nftower/internal/towertest"
 )
 
 // providerFactories are used to instantiate a provider during acceptance testing.
@@ -16,12 +21,119 @@ var providerFactories = map[string]func() (*schema.Provider, error){
 	},
 }
 
+// TestMain points the acceptance tests at an in-memory fake of the Tower API
+// when NFTOWER_FAKE_API is set, so they can run without a Tower account. It
+// runs the sweepers instead of the tests when -sweep is given.
+func TestMain(m *testing.M) {
+	if v := os.Getenv("NFTOWER_FAKE_API"); v == "1" {
+		// the server lives until the test binary exits
+		server := towertest.NewServer()
+
+		os.Setenv("NFTOWER_API_URL", server.URL)
+		os.Setenv("NFTOWER_API_KEY", towertest.APIKey)
+		os.Setenv("NFTOWER_ORGANIZATION", towertest.Organization)
+	}
+
+	resource.TestMain(m)
+}
+
 func TestProvider(t *testing.T) {
 	if err := New("dev")().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
+func TestProviderConfigure(t *testing.T) {
+	server := towertest.NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		env    map[string]string
+		err    bool
+	}{
+		{
+			name:   "defaults",
+			config: map[string]interface{}{},
+		},
+		{
+			name: "client settings",
+			config: map[string]interface{}{
+				"max_retries":         0,
+				"retry_wait_min":      5,
+				"retry_wait_max":      5,
+				"request_timeout":     0,
+				"requests_per_second": 0.5,
+				"burst":               1,
+				"list_cache_ttl":      0,
+			},
+		},
+		{
+			name:   "client settings from the environment",
+			config: map[string]interface{}{},
+			env: map[string]string{
+				"NFTOWER_MAX_RETRIES":         "10",
+				"NFTOWER_RETRY_WAIT_MIN":      "2",
+				"NFTOWER_RETRY_WAIT_MAX":      "120",
+				"NFTOWER_REQUEST_TIMEOUT":     "300",
+				"NFTOWER_REQUESTS_PER_SECOND": "2.5",
+				"NFTOWER_BURST":               "5",
+				"NFTOWER_LIST_CACHE_TTL":      "600",
+			},
+		},
+		{
+			name: "retry wait min above max",
+			config: map[string]interface{}{
+				"retry_wait_min": 60,
+				"retry_wait_max": 10,
+			},
+			err: true,
+		},
+		{
+			name: "no requests per second",
+			config: map[string]interface{}{
+				"requests_per_second": 0,
+			},
+			err: true,
+		},
+		{
+			name:   "no requests per second from the environment",
+			config: map[string]interface{}{},
+			env: map[string]string{
+				"NFTOWER_REQUESTS_PER_SECOND": "0",
+			},
+			err: true,
+		},
+		{
+			name:   "no burst from the environment",
+			config: map[string]interface{}{},
+			env: map[string]string{
+				"NFTOWER_BURST": "0",
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NFTOWER_API_URL", server.URL)
+			t.Setenv("NFTOWER_API_KEY", towertest.APIKey)
+			t.Setenv("NFTOWER_ORGANIZATION", towertest.Organization)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			p := New("dev")()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tt.config))
+
+			if diags.HasError() != tt.err {
+				t.Fatalf("expected an error: %t, got %v", tt.err, diags)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("TF_ACC"); v != "1" {
 		t.Skip("TF_ACC=1 must be set to run acceptance tests")
@@ -35,3 +147,25 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("NFTOWER_ORGANIZATION must be set for acceptance tests")
 	}
 }
+
+// testAccSkipUnlessFakeAPI skips acceptance tests which can only pass against
+// the fake Tower API, such as compute environments set up with dummy keys: a
+// real tower leaves them ERRORED.
+func testAccSkipUnlessFakeAPI(t *testing.T, reason string) {
+	if v := os.Getenv("NFTOWER_FAKE_API"); v != "1" {
+		t.Skipf("NFTOWER_FAKE_API=1 must be set to run this test, it %s", reason)
+	}
+}
+
+// testAccWorkspaceScopedImportStateIdFunc builds the workspace_id/id import id
+// used by resources which live inside a workspace.
+func testAccWorkspaceScopedImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.ID), nil
+	}
+}
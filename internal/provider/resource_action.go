@@ -18,6 +18,10 @@ func resourceAction() *schema.Resource {
 		UpdateContext: resourceActionUpdate,
 		DeleteContext: resourceActionDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
 				Description: "The id of the workspace in which the action should be created.",
@@ -194,7 +198,7 @@ func resourceActionRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		d.Get("workspace_id").(string),
 		d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -203,68 +207,19 @@ func resourceActionRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return nil
 	}
 
-	d.Set("name", action["name"].(string))
-	d.Set("source", action["source"].(string))
-	d.Set("status", action["status"].(string))
-	d.Set("hook_url", action["hookUrl"].(string))
-	d.Set("date_created", action["dateCreated"].(string))
-	d.Set("last_updated", action["lastUpdated"].(string))
-
-	launch := action["launch"].(map[string]interface{})
-	d.Set("pipeline", launch["pipeline"].(string))
-	d.Set("work_dir", launch["workDir"].(string))
-	d.Set("launch_id", launch["id"].(string))
-
-	computeEnv := launch["computeEnv"].(map[string]interface{})
-	d.Set("compute_environment_id", computeEnv["id"].(string))
-
-	if v, ok := launch["revision"].(string); ok {
-		d.Set("revision", v)
-	}
-
-	if v, ok := launch["preRunScript"].(string); ok {
-		d.Set("pre_run_script", v)
-	}
-
-	if v, ok := launch["postRunScript"].(string); ok {
-		d.Set("post_run_script", v)
-	}
-
-	if v, ok := launch["configProfiles"].([]interface{}); ok {
-		d.Set("config_profiles", v)
-	}
-
-	if v, ok := launch["paramsText"].(string); ok {
-		d.Set("pipeline_parameters", v)
-	}
-
-	if v, ok := launch["configText"].(string); ok {
-		d.Set("nextflow_config", v)
-	}
+	d.Set("name", action.Name)
+	d.Set("source", action.Source)
+	d.Set("status", action.Status)
+	d.Set("hook_url", action.HookUrl)
+	d.Set("date_created", action.DateCreated)
+	d.Set("last_updated", action.LastUpdated)
+	d.Set("labels", flattenLabels(action.Labels))
 
-	if v, ok := launch["towerConfig"].(string); ok {
-		d.Set("tower_config", v)
+	if action.Launch != nil {
+		d.Set("launch_id", action.Launch.Id)
 	}
 
-	if v, ok := launch["mainScript"].(string); ok {
-		d.Set("main_script", v)
-	}
-
-	if v, ok := launch["entryName"].(string); ok {
-		d.Set("workflow_entry_name", v)
-	}
-
-	if v, ok := launch["schema_name"].(string); ok {
-		d.Set("schema_name", v)
-	}
-
-	if v, ok := launch["workspaceSecrets"].([]interface{}); ok {
-		d.Set("workspace_secrets", v)
-	}
-
-	if v, ok := action["labels"].([]interface{}); ok {
-		d.Set("labels", flattenLabels(v))
-	}
+	setLaunchAttributes(d, action.Launch)
 
 	return nil
 }
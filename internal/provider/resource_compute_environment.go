@@ -2,8 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/healx/terraform-provider-nftower/internal/client"
@@ -11,25 +16,33 @@ import (
 
 func resourceComputeEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Description: "A workspace inside a tower organization.",
+		Description: "A compute environment inside a tower workspace. The name, description, credentials, pre and post run scripts and environment variables are updated in place, changing any other setting replaces the compute environment.",
 
 		CreateContext: resourceComputeEnvironmentCreate,
 		ReadContext:   resourceComputeEnvironmentRead,
+		UpdateContext: resourceComputeEnvironmentUpdate,
 		DeleteContext: resourceComputeEnvironmentDelete,
+		CustomizeDiff: resourceComputeEnvironmentCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description:  "The name of the environment. Only alphanumeric characters and dashes are allowed.",
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 40),
 			},
 			"description": {
 				Description:  "The description of the environment.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1000),
 			},
 			"workspace_id": {
@@ -40,9 +53,14 @@ func resourceComputeEnvironment() *schema.Resource {
 			},
 			"credentials_id": {
 				Type:        schema.TypeString,
-				Description: "The id of the credentials to use for the environment.",
+				Description: "The id of the credentials to use for the environment. HPC platforms accept either SSH or Tower Agent credentials.",
 				Required:    true,
-				ForceNew:    true,
+			},
+			"primary": {
+				Description: "Whether this is the primary compute environment of the workspace, used by pipelines without an explicit compute environment. A compute environment can only stop being primary by marking another one as primary.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
 			},
 			"status": {
 				Description: "The status of the workspace. Can be CREATING, AVAILABLE, ERRORED or INVALID.",
@@ -60,12 +78,12 @@ func resourceComputeEnvironment() *schema.Resource {
 				Computed:    true,
 			},
 			"aws_batch": {
-				Description:   "Configures an AWS Batch compute environment (manual only).",
-				Type:          schema.TypeList,
-				Optional:      true,
-				ForceNew:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"lsf_platform"},
+				Description:  "Configures an AWS Batch compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"region": {
@@ -75,16 +93,20 @@ func resourceComputeEnvironment() *schema.Resource {
 							ForceNew:    true,
 						},
 						"compute_queue": {
-							Type:        schema.TypeString,
-							Description: "The default Batch queue to which Nextflow will submit job executions. This can be overwritten via the usual Nextflow config.",
-							Required:    true,
-							ForceNew:    true,
+							Type:         schema.TypeString,
+							Description:  "The default Batch queue to which Nextflow will submit job executions. This can be overwritten via the usual Nextflow config. Required when no `forge` block is given.",
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"aws_batch.0.compute_queue", "aws_batch.0.forge"},
 						},
 						"head_queue": {
-							Type:        schema.TypeString,
-							Description: "The Batch queue that will run the Nextflow application. A queue that does not use spot instances is expected.",
-							Required:    true,
-							ForceNew:    true,
+							Type:         schema.TypeString,
+							Description:  "The Batch queue that will run the Nextflow application. A queue that does not use spot instances is expected. Required when no `forge` block is given.",
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"aws_batch.0.head_queue", "aws_batch.0.forge"},
 						},
 						"cli_path": {
 							Type:        schema.TypeString,
@@ -121,13 +143,11 @@ func resourceComputeEnvironment() *schema.Resource {
 							Type:        schema.TypeString,
 							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
 							Optional:    true,
-							ForceNew:    true,
 						},
 						"post_run_script": {
 							Type:        schema.TypeString,
 							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
 							Optional:    true,
-							ForceNew:    true,
 						},
 						"head_job_cpus": {
 							Type:        schema.TypeInt,
@@ -141,135 +161,563 @@ func resourceComputeEnvironment() *schema.Resource {
 							Optional:    true,
 							ForceNew:    true,
 						},
+						"forge": {
+							Type:        schema.TypeList,
+							Description: "Let Tower create the Batch compute environments and queues (Batch Forge).",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Description:  "The provisioning model of the compute instances. Can be SPOT or EC2.",
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"SPOT", "EC2"}, false),
+									},
+									"min_cpus": {
+										Type:        schema.TypeInt,
+										Description: "The minimum number of CPUs provisioned in the environment.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     0,
+									},
+									"max_cpus": {
+										Type:        schema.TypeInt,
+										Description: "The maximum number of CPUs provisioned in the environment.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"desired_cpus": {
+										Type:        schema.TypeInt,
+										Description: "The number of CPUs provisioned when the environment is created.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"instance_types": {
+										Type:        schema.TypeList,
+										Description: "A list of instance types or families which can be used for the compute jobs e.g. m5.xlarge or c5. Defaults to optimal.",
+										Optional:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"vpc_id": {
+										Type:        schema.TypeString,
+										Description: "The VPC in which the compute instances are launched. Defaults to the default VPC of the region.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"subnets": {
+										Type:        schema.TypeList,
+										Description: "A list of subnets of the VPC in which the compute instances are launched.",
+										Optional:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"security_groups": {
+										Type:        schema.TypeList,
+										Description: "A list of security groups attached to the compute instances.",
+										Optional:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"ebs_auto_scale": {
+										Type:        schema.TypeBool,
+										Description: "Grow the EBS volume of the compute instances automatically as the disk fills up.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     false,
+									},
+									"ebs_block_size": {
+										Type:        schema.TypeInt,
+										Description: "The initial size in GB of the EBS auto-expandable volume.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"fsx_name": {
+										Type:        schema.TypeString,
+										Description: "The DNS name of an existing FSx for Lustre file system to mount.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"fsx_mount": {
+										Type:        schema.TypeString,
+										Description: "The path where the FSx for Lustre file system is mounted in the compute instances.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"fsx_size": {
+										Type:        schema.TypeInt,
+										Description: "The size in GB of the FSx for Lustre file system.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"efs_id": {
+										Type:          schema.TypeString,
+										Description:   "The id of an existing EFS file system to mount.",
+										Optional:      true,
+										ForceNew:      true,
+										ConflictsWith: []string{"aws_batch.0.forge.0.efs_create"},
+									},
+									"efs_create": {
+										Type:          schema.TypeBool,
+										Description:   "Let Tower create an EFS file system for the environment.",
+										Optional:      true,
+										ForceNew:      true,
+										Default:       false,
+										ConflictsWith: []string{"aws_batch.0.forge.0.efs_id"},
+									},
+									"efs_mount": {
+										Type:        schema.TypeString,
+										Description: "The path where the EFS file system is mounted in the compute instances.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"fusion2_enabled": {
+										Type:        schema.TypeBool,
+										Description: "Enable Fusion v2 to access the S3 work directory as a file system. Requires `wave_enabled`.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     false,
+									},
+									"wave_enabled": {
+										Type:        schema.TypeBool,
+										Description: "Enable the Wave containers service.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     false,
+									},
+									"gpu_enabled": {
+										Type:        schema.TypeBool,
+										Description: "Use GPU enabled instances for the compute jobs.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     false,
+									},
+									"allow_buckets": {
+										Type:        schema.TypeList,
+										Description: "A list of S3 buckets the compute instances are granted access to, in addition to the work directory bucket.",
+										Optional:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"dispose_on_deletion": {
+										Type:        schema.TypeBool,
+										Description: "Delete the AWS resources created by Tower when the compute environment is deleted.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     true,
+									},
+									"ec2_key_pair": {
+										Type:        schema.TypeString,
+										Description: "The name of an EC2 key pair used to access the compute instances with SSH.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			"lsf_platform": {
-				Description:   "Configures an IBM LSF compute environment.",
-				Type:          schema.TypeList,
-				Optional:      true,
-				ForceNew:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"aws_batch"},
+				Description:  "Configures an IBM LSF compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCSchema("IBM LSF", "BSUB", map[string]*schema.Schema{
+						"per_job_mem_limit": {
+							Type:        schema.TypeBool,
+							Description: "Enable per-job mem limits.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"per_task_reserve": {
+							Type:        schema.TypeBool,
+							Description: "Enable per task reserve.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"unit_for_limits": {
+							Type:        schema.TypeString,
+							Description: "the unit to use for limits.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					}),
+				},
+			},
+			"slurm_platform": {
+				Description:  "Configures a Slurm compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCSchema("Slurm", "sbatch", nil),
+				},
+			},
+			"altair_platform": {
+				Description:  "Configures an Altair PBS Pro compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCSchema("Altair PBS Pro", "qsub", nil),
+				},
+			},
+			"uge_platform": {
+				Description:  "Configures a Grid Engine (SGE/UGE) compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCSchema("Grid Engine", "qsub", nil),
+				},
+			},
+			"moab_platform": {
+				Description:  "Configures a Moab compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentHPCSchema("Moab", "msub", nil),
+				},
+			},
+			"google_batch": {
+				Description:  "Configures a Google Batch compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"work_dir": {
+						"location": {
 							Type:        schema.TypeString,
-							Description: "The nextflow work directory.",
+							Description: "The Google Cloud location (region) where the jobs are executed e.g. europe-west2.",
 							Required:    true,
 							ForceNew:    true,
 						},
-						"launch_dir": {
+						"work_dir": {
 							Type:        schema.TypeString,
-							Description: "The directory where tower will launch workflows.",
+							Description: "A Google Cloud Storage bucket path e.g. gs://my-bucket/work. The bucket should be located in the same location as the one chosen previously.",
 							Required:    true,
 							ForceNew:    true,
 						},
-						"user_name": {
+						"spot": {
+							Type:        schema.TypeBool,
+							Description: "Use spot virtual machines for the compute jobs.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+						},
+						"use_private_address": {
+							Type:        schema.TypeBool,
+							Description: "Do not attach a public IP address to the virtual machines. Requires Private Google Access to be enabled on the subnetwork.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+						},
+						"boot_disk_size_gb": {
+							Type:        schema.TypeInt,
+							Description: "The size of the boot disk of the virtual machines in GB.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"cpu_platform": {
 							Type:        schema.TypeString,
-							Description: "IBM LSF username to use.",
-							Required:    true,
+							Description: "The minimum CPU platform of the virtual machines e.g. Intel Ice Lake.",
+							Optional:    true,
 							ForceNew:    true,
 						},
-						"host_name": {
+						"machine_types": {
+							Type:        schema.TypeList,
+							Description: "A list of machine types or families which can be used for the compute jobs e.g. n2-standard-4 or n2-*.",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"network": {
 							Type:        schema.TypeString,
-							Description: "hostname of the login node for your IBM LSF cluster.",
-							Required:    true,
+							Description: "The name or URL of the VPC network to attach the virtual machines to.",
+							Optional:    true,
 							ForceNew:    true,
 						},
-						"head_queue": {
+						"subnetwork": {
 							Type:        schema.TypeString,
-							Description: "The LSF queue that will run the Nextflow application. A queue that does not use spot instances is expected.",
-							Required:    true,
+							Description: "The name or URL of the subnetwork to attach the virtual machines to.",
+							Optional:    true,
 							ForceNew:    true,
 						},
-						"compute_queue": {
+						"service_account": {
 							Type:        schema.TypeString,
-							Description: "The default LSF queue to which Nextflow will submit job executions. This can be overwritten via the usual Nextflow config.",
-							Required:    true,
+							Description: "The email of the service account used by the virtual machines. Defaults to the compute engine default service account.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "A map of labels to apply to the Google Batch jobs and the resources they create.",
+							Optional:    true,
 							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
 						},
-						"head_job_options": {
+						"pre_run_script": {
 							Type:        schema.TypeString,
-							Description: "options to add to BSUB when submitting the head job.",
-							Required:    true,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
+							Optional:    true,
+						},
+						"post_run_script": {
+							Type:        schema.TypeString,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
+							Optional:    true,
+						},
+						"head_job_cpus": {
+							Type:        schema.TypeInt,
+							Description: "The number of CPUs to be allocated for the Nextflow runner job.",
+							Optional:    true,
 							ForceNew:    true,
 						},
-						"propagate_head_job_options": {
-							Type:        schema.TypeBool,
-							Description: "Whether to propagate the head job optoins to spawned worker jobs or not.",
-							Required:    true,
+						"head_job_memory_mb": {
+							Type:        schema.TypeInt,
+							Description: "The number of MiB of memory reserved for the Nextflow runner job.",
+							Optional:    true,
 							ForceNew:    true,
 						},
-						"per_job_mem_limit": {
-							Type:        schema.TypeBool,
-							Description: "Enable per-job mem limits.",
+					},
+				},
+			},
+			"azure_batch": {
+				Description:  "Configures an Azure Batch compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The Azure region where the Batch account lives e.g. uksouth.",
 							Required:    true,
 							ForceNew:    true,
 						},
-						"per_task_reserve": {
+						"work_dir": {
+							Type:         schema.TypeString,
+							Description:  "An Azure Blob Storage container path e.g. az://my-container/work. The storage account should be located in the same region as the one chosen previously.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^az://"), "must be an Azure Blob Storage container path starting with az://"),
+						},
+						"head_pool": {
+							Type:         schema.TypeString,
+							Description:  "The name of an existing Batch pool that will run the Nextflow application. Required when no `forge` block is given.",
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"azure_batch.0.head_pool", "azure_batch.0.forge"},
+						},
+						"auto_pool_mode": {
 							Type:        schema.TypeBool,
-							Description: "Enable per task reserve.",
-							Required:    true,
+							Description: "Let Nextflow create a Batch pool on demand for each compute job (auto-pool mode).",
+							Optional:    true,
 							ForceNew:    true,
+							Default:     false,
 						},
-						"port": {
-							Type:        schema.TypeInt,
-							Description: "The port for ssh connection.",
+						"forge": {
+							Type:        schema.TypeList,
+							Description: "Let Tower create the Batch pools for the environment (Batch Forge).",
 							Optional:    true,
 							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vm_type": {
+										Type:        schema.TypeString,
+										Description: "The virtual machine size of the pool nodes e.g. Standard_D4_v3.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"vm_count": {
+										Type:        schema.TypeInt,
+										Description: "The number of virtual machines in the pool. When `auto_scale` is enabled this is the maximum number of virtual machines.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"auto_scale": {
+										Type:        schema.TypeBool,
+										Description: "Scale the pool automatically between zero and `vm_count` virtual machines.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     true,
+									},
+									"dispose_on_deletion": {
+										Type:        schema.TypeBool,
+										Description: "Delete the Batch pool when the compute environment is deleted.",
+										Optional:    true,
+										ForceNew:    true,
+										Default:     true,
+									},
+									"container_registry_ids": {
+										Type:        schema.TypeList,
+										Description: "A list of container registry credentials ids to make available to the pool.",
+										Optional:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
 						},
-						"max_queue_size": {
-							Type:        schema.TypeInt,
-							Description: "Max size of queue.",
+						"delete_jobs_on_completion": {
+							Type:         schema.TypeString,
+							Description:  "When to delete the Batch jobs created by a workflow. Can be on_success, always or never.",
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"on_success", "always", "never"}, false),
+						},
+						"delete_pools_on_completion": {
+							Type:        schema.TypeBool,
+							Description: "Delete the pools created in auto-pool mode when the workflow completes.",
 							Optional:    true,
 							ForceNew:    true,
+							Default:     false,
 						},
-						"pre_run_script": {
+						"token_duration": {
 							Type:        schema.TypeString,
-							Description: "script to run on submission node before running nextflow.",
+							Description: "The duration of the SAS token generated by Nextflow to access the storage account e.g. 12h.",
 							Optional:    true,
 							ForceNew:    true,
 						},
+						"pre_run_script": {
+							Type:        schema.TypeString,
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
+							Optional:    true,
+						},
 						"post_run_script": {
 							Type:        schema.TypeString,
-							Description: "script to run on submission node after running nextflow.",
+							Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
 							Optional:    true,
+						},
+					},
+				},
+			},
+			"k8s_platform": {
+				Description:  "Configures a Kubernetes compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentKubernetesSchema(map[string]*schema.Schema{
+						"server": {
+							Type:        schema.TypeString,
+							Description: "The URL of the Kubernetes API server e.g. https://k8s.example.com:6443.",
+							Required:    true,
 							ForceNew:    true,
 						},
-						"unit_for_limits": {
+						"ssl_cert": {
 							Type:        schema.TypeString,
-							Description: "the unit to use for limits.",
-							Optional:    true,
+							Description: "The PEM encoded CA certificate used to verify the Kubernetes API server.",
+							Required:    true,
 							ForceNew:    true,
 						},
-					},
+					}),
+				},
+			},
+			"eks_platform": {
+				Description:  "Configures an Amazon EKS compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentKubernetesSchema(map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The AWS region where the EKS cluster lives.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the EKS cluster.",
+							Required:    true,
+							ForceNew:    true,
+						},
+					}),
+				},
+			},
+			"gke_platform": {
+				Description:  "Configures a Google Kubernetes Engine compute environment.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: computeEnvironmentPlatforms,
+				Elem: &schema.Resource{
+					Schema: computeEnvironmentKubernetesSchema(map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The Google Cloud region or zone where the GKE cluster lives.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the GKE cluster.",
+							Required:    true,
+							ForceNew:    true,
+						},
+					}),
 				},
 			},
 			"environment_variable": {
 				Type:        schema.TypeList,
 				Description: "A List of environment variables that can be included for head or compute jobs.",
 				Optional:    true,
-				ForceNew:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
 							Type:        schema.TypeString,
 							Description: "The name of the environment variable must contain only alphanumeric, dash and underscore characters, and cannot begin with a number.",
 							Required:    true,
-							ForceNew:    true,
 						},
 						"value": {
 							Type:        schema.TypeString,
 							Description: "The value of the environment variable.",
 							Required:    true,
-							ForceNew:    true,
 						},
 						"visibility": {
 							Type:         schema.TypeString,
 							Description:  "Which jobs this environment variable should be available to, can be HEAD, COMPUTE or BOTH.",
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice([]string{"COMPUTE", "HEAD", "BOTH"}, false),
 						},
 					},
@@ -279,6 +727,192 @@ func resourceComputeEnvironment() *schema.Resource {
 	}
 }
 
+// computeEnvironmentPlatforms lists the platform blocks of a compute
+// environment, exactly one of which must be given.
+var computeEnvironmentPlatforms = []string{
+	"altair_platform",
+	"aws_batch",
+	"azure_batch",
+	"eks_platform",
+	"gke_platform",
+	"google_batch",
+	"k8s_platform",
+	"lsf_platform",
+	"moab_platform",
+	"slurm_platform",
+	"uge_platform",
+}
+
+// computeEnvironmentHPCPlatforms maps the HPC platform blocks without platform
+// specific settings to their tower platform.
+var computeEnvironmentHPCPlatforms = map[string]string{
+	"slurm_platform":  "slurm-platform",
+	"altair_platform": "altair-platform",
+	"uge_platform":    "uge-platform",
+	"moab_platform":   "moab-platform",
+}
+
+// computeEnvironmentHPCSchema returns the settings shared by the HPC scheduler
+// platforms, merged with the platform specific ones.
+func computeEnvironmentHPCSchema(scheduler string, submitCommand string, platformSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"work_dir": {
+			Type:        schema.TypeString,
+			Description: "The nextflow work directory.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"launch_dir": {
+			Type:        schema.TypeString,
+			Description: "The directory where tower will launch workflows.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"user_name": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("%s username to use. Required when connecting with SSH credentials.", scheduler),
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"host_name": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("hostname of the login node for your %s cluster. Required when connecting with SSH credentials.", scheduler),
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"head_queue": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The %s queue that will run the Nextflow application. A queue that does not use spot instances is expected.", scheduler),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"compute_queue": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("The default %s queue to which Nextflow will submit job executions. This can be overwritten via the usual Nextflow config.", scheduler),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"head_job_options": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("options to add to %s when submitting the head job.", submitCommand),
+			Required:    true,
+			ForceNew:    true,
+		},
+		"propagate_head_job_options": {
+			Type:        schema.TypeBool,
+			Description: "Whether to propagate the head job optoins to spawned worker jobs or not.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "The port for ssh connection.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"max_queue_size": {
+			Type:        schema.TypeInt,
+			Description: "Max size of queue.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"pre_run_script": {
+			Type:        schema.TypeString,
+			Description: "script to run on submission node before running nextflow.",
+			Optional:    true,
+		},
+		"post_run_script": {
+			Type:        schema.TypeString,
+			Description: "script to run on submission node after running nextflow.",
+			Optional:    true,
+		},
+	}
+
+	for k, v := range platformSchema {
+		s[k] = v
+	}
+
+	return s
+}
+
+// computeEnvironmentKubernetesSchema returns the settings shared by the
+// Kubernetes based platforms, merged with the platform specific ones.
+func computeEnvironmentKubernetesSchema(platformSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"work_dir": {
+			Type:        schema.TypeString,
+			Description: "The Nextflow work directory. It must be a path within the storage mount path.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "The Kubernetes namespace in which the pods are launched.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"head_service_account": {
+			Type:        schema.TypeString,
+			Description: "The Kubernetes service account used by the Nextflow head pod.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"compute_service_account": {
+			Type:        schema.TypeString,
+			Description: "The Kubernetes service account used by the Nextflow compute pods.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"storage_claim_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the persistent volume claim used as the Nextflow scratch storage.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"storage_mount_path": {
+			Type:        schema.TypeString,
+			Description: "The path where the persistent volume claim is mounted in the pods.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"pod_cleanup": {
+			Type:         schema.TypeString,
+			Description:  "When to delete the pods created by a workflow. Can be on_success, always or never.",
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on_success", "always", "never"}, false),
+		},
+		"head_pod_spec": {
+			Type:        schema.TypeString,
+			Description: "A custom YAML pod specification applied to the Nextflow head pod.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"service_pod_spec": {
+			Type:        schema.TypeString,
+			Description: "A custom YAML pod specification applied to the Tower service pods e.g. the data copy pods.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"pre_run_script": {
+			Type:        schema.TypeString,
+			Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs just before the pipeline is launched. It can useful to stage input data or similar tasks.",
+			Optional:    true,
+		},
+		"post_run_script": {
+			Type:        schema.TypeString,
+			Description: "This is an optional Bash script that's executed in the same environment where Nextflow runs immediately after the pipeline completion. The script is executed either the pipeline completes successfully or with an error condition. The error condition can be verified using the environment variable NXF_EXIT_STATUS. It can useful to copy result data or similar tasks.",
+			Optional:    true,
+		},
+	}
+
+	for k, v := range platformSchema {
+		s[k] = v
+	}
+
+	return s
+}
+
 func resourceComputeEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	tower_client := meta.(*client.TowerClient)
 	var err error
@@ -302,23 +936,142 @@ func resourceComputeEnvironmentCreate(ctx context.Context, d *schema.ResourceDat
 			d.Get("credentials_id").(string),
 			expandComputeEnvironmentLSFPlatform(ctx, d),
 		)
-	}
-
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(id)
-
-	return resourceComputeEnvironmentRead(ctx, d, meta)
-}
-
-func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	} else if _, ok := d.GetOk("google_batch"); ok {
+		id, err = tower_client.CreateGoogleBatchComputeEnv(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("credentials_id").(string),
+			expandComputeEnvironmentGoogleBatch(ctx, d),
+		)
+	} else if _, ok := d.GetOk("azure_batch"); ok {
+		id, err = tower_client.CreateAzureBatchComputeEnv(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("credentials_id").(string),
+			expandComputeEnvironmentAzureBatch(ctx, d),
+		)
+	} else if _, ok := d.GetOk("k8s_platform"); ok {
+		id, err = tower_client.CreateK8sPlatformComputeEnv(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("credentials_id").(string),
+			expandComputeEnvironmentK8sPlatform(ctx, d),
+		)
+	} else if _, ok := d.GetOk("eks_platform"); ok {
+		id, err = tower_client.CreateEKSPlatformComputeEnv(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("credentials_id").(string),
+			expandComputeEnvironmentEKSPlatform(ctx, d),
+		)
+	} else if _, ok := d.GetOk("gke_platform"); ok {
+		id, err = tower_client.CreateGKEPlatformComputeEnv(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("credentials_id").(string),
+			expandComputeEnvironmentGKEPlatform(ctx, d),
+		)
+	} else {
+		for block, platform := range computeEnvironmentHPCPlatforms {
+			if _, ok := d.GetOk(block); ok {
+				id, err = tower_client.CreateHPCPlatformComputeEnv(
+					ctx,
+					d.Get("workspace_id").(string),
+					platform,
+					d.Get("name").(string),
+					d.Get("description").(string),
+					d.Get("credentials_id").(string),
+					expandComputeEnvironmentHPC(d, block),
+				)
+			}
+		}
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	err = waitForComputeEnvironmentAvailable(ctx, tower_client, d.Get("workspace_id").(string), id, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("primary").(bool) {
+		err = tower_client.SetPrimaryComputeEnv(ctx, d.Get("workspace_id").(string), id)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceComputeEnvironmentRead(ctx, d, meta)
+}
+
+// computeEnvironmentStatusDelay and computeEnvironmentStatusMinTimeout pace the
+// polling of new compute environments, the tests shorten them.
+var (
+	computeEnvironmentStatusDelay      = 2 * time.Second
+	computeEnvironmentStatusMinTimeout = 5 * time.Second
+)
+
+// waitForComputeEnvironmentAvailable polls the compute environment until tower
+// has finished creating it, failing when it ends up ERRORED or INVALID.
+func waitForComputeEnvironmentAvailable(ctx context.Context, towerClient *client.TowerClient, workspaceId string, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"AVAILABLE"},
+		Refresh:    computeEnvironmentStatusRefreshFunc(ctx, towerClient, workspaceId, id),
+		Timeout:    timeout,
+		Delay:      computeEnvironmentStatusDelay,
+		MinTimeout: computeEnvironmentStatusMinTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func computeEnvironmentStatusRefreshFunc(ctx context.Context, towerClient *client.TowerClient, workspaceId string, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		computeEnv, err := towerClient.GetComputeEnv(ctx, workspaceId, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if computeEnv == nil {
+			return nil, "", fmt.Errorf("compute environment %s not found", id)
+		}
+
+		status := computeEnv.Status
+
+		if status == "ERRORED" || status == "INVALID" {
+			return computeEnv, status, fmt.Errorf("compute environment %s is %s: %s", id, status, computeEnv.Message)
+		}
+
+		return computeEnv, status, nil
+	}
+}
+
+func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	towerClient := meta.(*client.TowerClient)
 
 	computeEnv, err := towerClient.GetComputeEnv(ctx, d.Get("workspace_id").(string), d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -327,29 +1080,153 @@ func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 		return nil
 	}
 
-	d.Set("name", computeEnv["name"].(string))
-	d.Set("description", computeEnv["description"].(string))
-	d.Set("credentials_id", computeEnv["credentialsId"].(string))
-	d.Set("date_created", computeEnv["dateCreated"].(string))
-	d.Set("last_updated", computeEnv["lastUpdated"].(string))
-	d.Set("status", computeEnv["status"].(string))
+	d.Set("name", computeEnv.Name)
+
+	d.Set("description", computeEnv.Description)
+
+	d.Set("credentials_id", computeEnv.CredentialsId)
+	d.Set("date_created", computeEnv.DateCreated)
+	d.Set("last_updated", computeEnv.LastUpdated)
+	d.Set("status", computeEnv.Status)
+
+	d.Set("primary", computeEnv.Primary)
 
-	switch computeEnv["platform"].(string) {
+	return setComputeEnvironmentConfig(ctx, d, computeEnv)
+}
+
+// setComputeEnvironmentConfig sets the platform block and the environment
+// variables from the config of the compute environment.
+func setComputeEnvironmentConfig(ctx context.Context, d *schema.ResourceData, computeEnv *client.ComputeEnv) diag.Diagnostics {
+	switch computeEnv.Platform {
 	case "aws-batch":
-		config := computeEnv["config"].(client.ComputeEnvAWSBatchConfig)
+		config, ok := computeEnv.Config.(client.ComputeEnvAWSBatchConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
 		d.Set("aws_batch", flattenComputeEnvironmentAWSBatch(ctx, &config))
 		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
 	case "lsf-platform":
-		config := computeEnv["config"].(client.ComputeEnvLSFPlatformConfig)
+		config, ok := computeEnv.Config.(client.ComputeEnvLSFPlatformConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
 		d.Set("lsf_platform", flattenComputeEnvironmentLSFPlatform(ctx, &config))
 		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "slurm-platform", "altair-platform", "uge-platform", "moab-platform":
+		platform := computeEnv.Platform
+		config, ok := computeEnv.Config.(client.ComputeEnvHPCPlatformConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
+		d.Set(strings.Replace(platform, "-", "_", 1), flattenComputeEnvironmentHPCPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "google-batch":
+		config, ok := computeEnv.Config.(client.ComputeEnvGoogleBatchConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
+		d.Set("google_batch", flattenComputeEnvironmentGoogleBatch(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "azure-batch":
+		config, ok := computeEnv.Config.(client.ComputeEnvAzureBatchConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
+		d.Set("azure_batch", flattenComputeEnvironmentAzureBatch(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "k8s-platform":
+		config, ok := computeEnv.Config.(client.ComputeEnvK8sPlatformConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
+		d.Set("k8s_platform", flattenComputeEnvironmentK8sPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "eks-platform":
+		config, ok := computeEnv.Config.(client.ComputeEnvEKSPlatformConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
+		d.Set("eks_platform", flattenComputeEnvironmentEKSPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
+	case "gke-platform":
+		config, ok := computeEnv.Config.(client.ComputeEnvGKEPlatformConfig)
+		if !ok {
+			return computeEnvironmentConfigError(computeEnv)
+		}
+		d.Set("gke_platform", flattenComputeEnvironmentGKEPlatform(ctx, &config))
+		d.Set("environment_variable", flattenComputeEnvironmentVariables(config.Environment))
 	default:
-		return diag.Errorf("unsupported platform type: %s", computeEnv["platform"].(string))
+		return diag.Errorf("unsupported platform type: %s", computeEnv.Platform)
 	}
 
 	return nil
 }
 
+// computeEnvironmentConfigError reports a config which does not match the
+// platform of the compute environment.
+func computeEnvironmentConfigError(computeEnv *client.ComputeEnv) diag.Diagnostics {
+	return diag.Errorf("unexpected config %T for the %s platform of compute environment %s", computeEnv.Config, computeEnv.Platform, computeEnv.Id)
+}
+
+func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	towerClient := meta.(*client.TowerClient)
+
+	if d.HasChange("primary") && !d.Get("primary").(bool) {
+		return diag.Errorf("compute environment %s cannot be unset as primary, mark another compute environment as primary instead", d.Id())
+	}
+
+	if d.HasChangeExcept("primary") {
+		platform := computeEnvironmentPlatform(d)
+
+		err := towerClient.UpdateComputeEnv(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Id(),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("credentials_id").(string),
+			d.Get(platform+".0.pre_run_script").(string),
+			d.Get(platform+".0.post_run_script").(string),
+			expandComputeEnvironmentVariables(d),
+		)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("primary") {
+		err := towerClient.SetPrimaryComputeEnv(ctx, d.Get("workspace_id").(string), d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceComputeEnvironmentRead(ctx, d, meta)
+}
+
+// resourceComputeEnvironmentCustomizeDiff checks the settings which depend on
+// the value of another setting, which the schema cannot express.
+func resourceComputeEnvironmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Get("aws_batch.0.forge.0.fusion2_enabled").(bool) && !d.Get("aws_batch.0.forge.0.wave_enabled").(bool) {
+		return fmt.Errorf("aws_batch.0.forge.0.fusion2_enabled requires aws_batch.0.forge.0.wave_enabled to be true")
+	}
+
+	return nil
+}
+
+// computeEnvironmentPlatform returns the platform block which is set.
+func computeEnvironmentPlatform(d *schema.ResourceData) string {
+	for _, platform := range computeEnvironmentPlatforms {
+		if _, ok := d.GetOk(platform); ok {
+			return platform
+		}
+	}
+
+	return ""
+}
+
 func resourceComputeEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*client.TowerClient)
 
@@ -372,6 +1249,12 @@ func expandComputeEnvironmentAWSBatch(ctx context.Context, d *schema.ResourceDat
 		Environment:  expandComputeEnvironmentVariables(d),
 	}
 
+	if _, ok := d.GetOk("aws_batch.0.forge"); ok {
+		awsBatchConfig.Forge = expandComputeEnvironmentAWSBatchForge(d)
+		awsBatchConfig.Fusion2Enabled = d.Get("aws_batch.0.forge.0.fusion2_enabled").(bool)
+		awsBatchConfig.WaveEnabled = d.Get("aws_batch.0.forge.0.wave_enabled").(bool)
+	}
+
 	if v, ok := d.GetOk("aws_batch.0.compute_job_role"); ok {
 		awsBatchConfig.ComputeJobRole = v.(string)
 	}
@@ -441,65 +1324,219 @@ func flattenComputeEnvironmentAWSBatch(ctx context.Context, config *client.Compu
 		flattened["post_run_script"] = config.PostRunScript
 	}
 
+	if config.Forge != nil {
+		forge := flattenComputeEnvironmentAWSBatchForge(config.Forge)
+		forge["fusion2_enabled"] = config.Fusion2Enabled
+		forge["wave_enabled"] = config.WaveEnabled
+
+		flattened["forge"] = []interface{}{forge}
+	}
+
 	v := make([]interface{}, 1)
 	v[0] = flattened
 
 	return v
 }
 
-func expandComputeEnvironmentLSFPlatform(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvLSFPlatformConfig {
-	lsfPlatformConfig := &client.ComputeEnvLSFPlatformConfig{
-		WorkDir:                 d.Get("lsf_platform.0.work_dir").(string),
-		LaunchDir:               d.Get("lsf_platform.0.launch_dir").(string),
-		UserName:                d.Get("lsf_platform.0.user_name").(string),
-		HostName:                d.Get("lsf_platform.0.host_name").(string),
-		HeadQueue:               d.Get("lsf_platform.0.head_queue").(string),
-		ComputeQueue:            d.Get("lsf_platform.0.compute_queue").(string),
-		HeadJobOptions:          d.Get("lsf_platform.0.head_job_options").(string),
-		PropagateHeadJobOptions: d.Get("lsf_platform.0.propagate_head_job_options").(bool),
-		Environment:             expandComputeEnvironmentVariables(d),
+func expandComputeEnvironmentAWSBatchForge(d *schema.ResourceData) *client.ComputeEnvAWSBatchForgeConfig {
+	forgeConfig := &client.ComputeEnvAWSBatchForgeConfig{
+		Type:              d.Get("aws_batch.0.forge.0.type").(string),
+		MinCpus:           d.Get("aws_batch.0.forge.0.min_cpus").(int),
+		MaxCpus:           d.Get("aws_batch.0.forge.0.max_cpus").(int),
+		GpuEnabled:        d.Get("aws_batch.0.forge.0.gpu_enabled").(bool),
+		EbsAutoScale:      d.Get("aws_batch.0.forge.0.ebs_auto_scale").(bool),
+		EfsCreate:         d.Get("aws_batch.0.forge.0.efs_create").(bool),
+		DisposeOnDeletion: d.Get("aws_batch.0.forge.0.dispose_on_deletion").(bool),
+		InstanceTypes:     expandStringList(d.Get("aws_batch.0.forge.0.instance_types").([]interface{})),
+		Subnets:           expandStringList(d.Get("aws_batch.0.forge.0.subnets").([]interface{})),
+		SecurityGroups:    expandStringList(d.Get("aws_batch.0.forge.0.security_groups").([]interface{})),
+		AllowBuckets:      expandStringList(d.Get("aws_batch.0.forge.0.allow_buckets").([]interface{})),
 	}
 
-	// port
-	if v, ok := d.GetOk("lsf_platform.0.port"); ok {
-		lsfPlatformConfig.Port = v.(int)
+	if v, ok := d.GetOk("aws_batch.0.forge.0.desired_cpus"); ok {
+		forgeConfig.DesiredCpus = v.(int)
 	}
 
-	// maxQueueSize
-	if v, ok := d.GetOk("lsf_platform.0.max_queue_size"); ok {
-		lsfPlatformConfig.MaxQueueSize = v.(int)
+	if v, ok := d.GetOk("aws_batch.0.forge.0.vpc_id"); ok {
+		forgeConfig.VpcId = v.(string)
 	}
 
-	// preRunScript
-	if v, ok := d.GetOk("lsf_platform.0.pre_run_script"); ok {
-		lsfPlatformConfig.PreRunScript = v.(string)
+	if v, ok := d.GetOk("aws_batch.0.forge.0.ebs_block_size"); ok {
+		forgeConfig.EbsBlockSize = v.(int)
 	}
 
-	// postRunScript
-	if v, ok := d.GetOk("lsf_platform.0.post_run_script"); ok {
-		lsfPlatformConfig.PostRunScript = v.(string)
+	if v, ok := d.GetOk("aws_batch.0.forge.0.fsx_name"); ok {
+		forgeConfig.FsxName = v.(string)
 	}
 
-	// unitForLimits
-	if v, ok := d.GetOk("lsf_platform.0.unit_for_limits"); ok {
-		lsfPlatformConfig.UnitForLimits = v.(string)
+	if v, ok := d.GetOk("aws_batch.0.forge.0.fsx_mount"); ok {
+		forgeConfig.FsxMount = v.(string)
+	}
+
+	if v, ok := d.GetOk("aws_batch.0.forge.0.fsx_size"); ok {
+		forgeConfig.FsxSize = v.(int)
+	}
+
+	if v, ok := d.GetOk("aws_batch.0.forge.0.efs_id"); ok {
+		forgeConfig.EfsId = v.(string)
+	}
+
+	if v, ok := d.GetOk("aws_batch.0.forge.0.efs_mount"); ok {
+		forgeConfig.EfsMount = v.(string)
+	}
+
+	if v, ok := d.GetOk("aws_batch.0.forge.0.ec2_key_pair"); ok {
+		forgeConfig.Ec2KeyPair = v.(string)
 	}
 
-	// perJobMemLimits
-	if v, ok := d.GetOk("lsf_platform.0.per_job_mem_limits"); ok {
-		lsfPlatformConfig.PerJobMemLimit = v.(bool)
+	return forgeConfig
+}
+
+func flattenComputeEnvironmentAWSBatchForge(config *client.ComputeEnvAWSBatchForgeConfig) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"type":                config.Type,
+		"min_cpus":            config.MinCpus,
+		"max_cpus":            config.MaxCpus,
+		"gpu_enabled":         config.GpuEnabled,
+		"ebs_auto_scale":      config.EbsAutoScale,
+		"efs_create":          config.EfsCreate,
+		"dispose_on_deletion": config.DisposeOnDeletion,
 	}
 
-	// perTaskReserve
-	if v, ok := d.GetOk("lsf_platform.0.per_task_reserve"); ok {
-		lsfPlatformConfig.PerTaskReserve = v.(bool)
+	if config.DesiredCpus != 0 {
+		flattened["desired_cpus"] = config.DesiredCpus
+	}
+
+	if len(config.InstanceTypes) > 0 {
+		flattened["instance_types"] = config.InstanceTypes
+	}
+
+	if config.VpcId != "" {
+		flattened["vpc_id"] = config.VpcId
+	}
+
+	if len(config.Subnets) > 0 {
+		flattened["subnets"] = config.Subnets
+	}
+
+	if len(config.SecurityGroups) > 0 {
+		flattened["security_groups"] = config.SecurityGroups
+	}
+
+	if config.EbsBlockSize != 0 {
+		flattened["ebs_block_size"] = config.EbsBlockSize
+	}
+
+	if config.FsxName != "" {
+		flattened["fsx_name"] = config.FsxName
+	}
+
+	if config.FsxMount != "" {
+		flattened["fsx_mount"] = config.FsxMount
+	}
+
+	if config.FsxSize != 0 {
+		flattened["fsx_size"] = config.FsxSize
+	}
+
+	if config.EfsId != "" {
+		flattened["efs_id"] = config.EfsId
+	}
+
+	if config.EfsMount != "" {
+		flattened["efs_mount"] = config.EfsMount
+	}
+
+	if len(config.AllowBuckets) > 0 {
+		flattened["allow_buckets"] = config.AllowBuckets
+	}
+
+	if config.Ec2KeyPair != "" {
+		flattened["ec2_key_pair"] = config.Ec2KeyPair
+	}
+
+	return flattened
+}
+
+func expandComputeEnvironmentLSFPlatform(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvLSFPlatformConfig {
+	lsfPlatformConfig := &client.ComputeEnvLSFPlatformConfig{
+		ComputeEnvHPCPlatformConfig: *expandComputeEnvironmentHPC(d, "lsf_platform"),
+		PerJobMemLimit:              d.Get("lsf_platform.0.per_job_mem_limit").(bool),
+		PerTaskReserve:              d.Get("lsf_platform.0.per_task_reserve").(bool),
+	}
+
+	// unitForLimits
+	if v, ok := d.GetOk("lsf_platform.0.unit_for_limits"); ok {
+		lsfPlatformConfig.UnitForLimits = v.(string)
 	}
 
 	return lsfPlatformConfig
 }
 
 func flattenComputeEnvironmentLSFPlatform(ctx context.Context, config *client.ComputeEnvLSFPlatformConfig) []interface{} {
+	flattened := flattenComputeEnvironmentHPC(&config.ComputeEnvHPCPlatformConfig)
+
+	flattened["per_job_mem_limit"] = config.PerJobMemLimit
+	flattened["per_task_reserve"] = config.PerTaskReserve
+
+	if config.UnitForLimits != "" {
+		flattened["unit_for_limits"] = config.UnitForLimits
+	}
+
+	v := make([]interface{}, 1)
+	v[0] = flattened
+
+	return v
+}
+
+func flattenComputeEnvironmentHPCPlatform(ctx context.Context, config *client.ComputeEnvHPCPlatformConfig) []interface{} {
+	v := make([]interface{}, 1)
+	v[0] = flattenComputeEnvironmentHPC(config)
+
+	return v
+}
+
+// expandComputeEnvironmentHPC reads the settings shared by the HPC scheduler
+// platforms from the given platform block.
+func expandComputeEnvironmentHPC(d *schema.ResourceData, platform string) *client.ComputeEnvHPCPlatformConfig {
+	prefix := platform + ".0."
+
+	hpcConfig := &client.ComputeEnvHPCPlatformConfig{
+		WorkDir:                 d.Get(prefix + "work_dir").(string),
+		LaunchDir:               d.Get(prefix + "launch_dir").(string),
+		UserName:                d.Get(prefix + "user_name").(string),
+		HostName:                d.Get(prefix + "host_name").(string),
+		HeadQueue:               d.Get(prefix + "head_queue").(string),
+		ComputeQueue:            d.Get(prefix + "compute_queue").(string),
+		HeadJobOptions:          d.Get(prefix + "head_job_options").(string),
+		PropagateHeadJobOptions: d.Get(prefix + "propagate_head_job_options").(bool),
+		Environment:             expandComputeEnvironmentVariables(d),
+	}
+
+	// port
+	if v, ok := d.GetOk(prefix + "port"); ok {
+		hpcConfig.Port = v.(int)
+	}
+
+	// maxQueueSize
+	if v, ok := d.GetOk(prefix + "max_queue_size"); ok {
+		hpcConfig.MaxQueueSize = v.(int)
+	}
 
+	// preRunScript
+	if v, ok := d.GetOk(prefix + "pre_run_script"); ok {
+		hpcConfig.PreRunScript = v.(string)
+	}
+
+	// postRunScript
+	if v, ok := d.GetOk(prefix + "post_run_script"); ok {
+		hpcConfig.PostRunScript = v.(string)
+	}
+
+	return hpcConfig
+}
+
+func flattenComputeEnvironmentHPC(config *client.ComputeEnvHPCPlatformConfig) map[string]interface{} {
 	flattened := map[string]interface{}{
 		"work_dir":                   config.WorkDir,
 		"launch_dir":                 config.LaunchDir,
@@ -509,8 +1546,6 @@ func flattenComputeEnvironmentLSFPlatform(ctx context.Context, config *client.Co
 		"compute_queue":              config.ComputeQueue,
 		"head_job_options":           config.HeadJobOptions,
 		"propagate_head_job_options": config.PropagateHeadJobOptions,
-		"per_job_mem_limit":          config.PerJobMemLimit,
-		"per_task_reserve":           config.PerTaskReserve,
 	}
 
 	if config.Port != 0 {
@@ -529,16 +1564,377 @@ func flattenComputeEnvironmentLSFPlatform(ctx context.Context, config *client.Co
 		flattened["post_run_script"] = config.PostRunScript
 	}
 
-	if config.UnitForLimits != "" {
-		flattened["unit_for_limits"] = config.UnitForLimits
+	return flattened
+}
+
+func expandComputeEnvironmentGoogleBatch(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvGoogleBatchConfig {
+	googleBatchConfig := &client.ComputeEnvGoogleBatchConfig{
+		Location:          d.Get("google_batch.0.location").(string),
+		WorkDir:           d.Get("google_batch.0.work_dir").(string),
+		Spot:              d.Get("google_batch.0.spot").(bool),
+		UsePrivateAddress: d.Get("google_batch.0.use_private_address").(bool),
+		Environment:       expandComputeEnvironmentVariables(d),
+	}
+
+	if v, ok := d.GetOk("google_batch.0.boot_disk_size_gb"); ok {
+		googleBatchConfig.BootDiskSizeGb = v.(int)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.cpu_platform"); ok {
+		googleBatchConfig.CpuPlatform = v.(string)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.machine_types"); ok {
+		machineTypes := []string{}
+		for _, m := range v.([]interface{}) {
+			machineTypes = append(machineTypes, m.(string))
+		}
+		googleBatchConfig.MachineType = strings.Join(machineTypes, ",")
+	}
+
+	if v, ok := d.GetOk("google_batch.0.network"); ok {
+		googleBatchConfig.Network = v.(string)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.subnetwork"); ok {
+		googleBatchConfig.Subnetwork = v.(string)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.service_account"); ok {
+		googleBatchConfig.ServiceAccount = v.(string)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.labels"); ok {
+		labels := map[string]string{}
+		for k, l := range v.(map[string]interface{}) {
+			labels[k] = l.(string)
+		}
+		googleBatchConfig.Labels = labels
+	}
+
+	if v, ok := d.GetOk("google_batch.0.pre_run_script"); ok {
+		googleBatchConfig.PreRunScript = v.(string)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.post_run_script"); ok {
+		googleBatchConfig.PostRunScript = v.(string)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.head_job_cpus"); ok {
+		googleBatchConfig.HeadJobCpus = v.(int)
+	}
+
+	if v, ok := d.GetOk("google_batch.0.head_job_memory_mb"); ok {
+		googleBatchConfig.HeadJobMemoryMb = v.(int)
+	}
+
+	return googleBatchConfig
+}
+
+func flattenComputeEnvironmentGoogleBatch(ctx context.Context, config *client.ComputeEnvGoogleBatchConfig) []interface{} {
+
+	flattened := map[string]interface{}{
+		"location":            config.Location,
+		"work_dir":            config.WorkDir,
+		"spot":                config.Spot,
+		"use_private_address": config.UsePrivateAddress,
+	}
+
+	if config.BootDiskSizeGb != 0 {
+		flattened["boot_disk_size_gb"] = config.BootDiskSizeGb
+	}
+
+	if config.CpuPlatform != "" {
+		flattened["cpu_platform"] = config.CpuPlatform
+	}
+
+	if config.MachineType != "" {
+		flattened["machine_types"] = strings.Split(config.MachineType, ",")
+	}
+
+	if config.Network != "" {
+		flattened["network"] = config.Network
+	}
+
+	if config.Subnetwork != "" {
+		flattened["subnetwork"] = config.Subnetwork
+	}
+
+	if config.ServiceAccount != "" {
+		flattened["service_account"] = config.ServiceAccount
+	}
+
+	if len(config.Labels) > 0 {
+		flattened["labels"] = config.Labels
+	}
+
+	if config.PreRunScript != "" {
+		flattened["pre_run_script"] = config.PreRunScript
+	}
+
+	if config.PostRunScript != "" {
+		flattened["post_run_script"] = config.PostRunScript
+	}
+
+	if config.HeadJobCpus != 0 {
+		flattened["head_job_cpus"] = config.HeadJobCpus
+	}
+
+	if config.HeadJobMemoryMb != 0 {
+		flattened["head_job_memory_mb"] = config.HeadJobMemoryMb
+	}
+
+	v := make([]interface{}, 1)
+	v[0] = flattened
+
+	return v
+}
+
+func expandComputeEnvironmentAzureBatch(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvAzureBatchConfig {
+	azureBatchConfig := &client.ComputeEnvAzureBatchConfig{
+		Region:                  d.Get("azure_batch.0.region").(string),
+		WorkDir:                 d.Get("azure_batch.0.work_dir").(string),
+		AutoPoolMode:            d.Get("azure_batch.0.auto_pool_mode").(bool),
+		DeletePoolsOnCompletion: d.Get("azure_batch.0.delete_pools_on_completion").(bool),
+		Environment:             expandComputeEnvironmentVariables(d),
+	}
+
+	if v, ok := d.GetOk("azure_batch.0.head_pool"); ok {
+		azureBatchConfig.HeadPool = v.(string)
+	}
+
+	if _, ok := d.GetOk("azure_batch.0.forge"); ok {
+		azureBatchConfig.Forge = &client.ComputeEnvAzureBatchForgeConfig{
+			VmType:            d.Get("azure_batch.0.forge.0.vm_type").(string),
+			VmCount:           d.Get("azure_batch.0.forge.0.vm_count").(int),
+			AutoScale:         d.Get("azure_batch.0.forge.0.auto_scale").(bool),
+			DisposeOnDeletion: d.Get("azure_batch.0.forge.0.dispose_on_deletion").(bool),
+		}
+
+		for _, v := range d.Get("azure_batch.0.forge.0.container_registry_ids").([]interface{}) {
+			azureBatchConfig.Forge.ContainerRegIds = append(azureBatchConfig.Forge.ContainerRegIds, v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("azure_batch.0.delete_jobs_on_completion"); ok {
+		azureBatchConfig.DeleteJobsOnCompletion = v.(string)
+	}
+
+	if v, ok := d.GetOk("azure_batch.0.token_duration"); ok {
+		azureBatchConfig.TokenDuration = v.(string)
+	}
+
+	if v, ok := d.GetOk("azure_batch.0.pre_run_script"); ok {
+		azureBatchConfig.PreRunScript = v.(string)
+	}
+
+	if v, ok := d.GetOk("azure_batch.0.post_run_script"); ok {
+		azureBatchConfig.PostRunScript = v.(string)
+	}
+
+	return azureBatchConfig
+}
+
+func flattenComputeEnvironmentAzureBatch(ctx context.Context, config *client.ComputeEnvAzureBatchConfig) []interface{} {
+
+	flattened := map[string]interface{}{
+		"region":                     config.Region,
+		"work_dir":                   config.WorkDir,
+		"auto_pool_mode":             config.AutoPoolMode,
+		"delete_pools_on_completion": config.DeletePoolsOnCompletion,
+	}
+
+	if config.HeadPool != "" {
+		flattened["head_pool"] = config.HeadPool
+	}
+
+	if config.Forge != nil {
+		forge := map[string]interface{}{
+			"vm_type":             config.Forge.VmType,
+			"vm_count":            config.Forge.VmCount,
+			"auto_scale":          config.Forge.AutoScale,
+			"dispose_on_deletion": config.Forge.DisposeOnDeletion,
+		}
+
+		if len(config.Forge.ContainerRegIds) > 0 {
+			forge["container_registry_ids"] = config.Forge.ContainerRegIds
+		}
+
+		flattened["forge"] = []interface{}{forge}
 	}
 
+	if config.DeleteJobsOnCompletion != "" {
+		flattened["delete_jobs_on_completion"] = config.DeleteJobsOnCompletion
+	}
+
+	if config.TokenDuration != "" {
+		flattened["token_duration"] = config.TokenDuration
+	}
+
+	if config.PreRunScript != "" {
+		flattened["pre_run_script"] = config.PreRunScript
+	}
+
+	if config.PostRunScript != "" {
+		flattened["post_run_script"] = config.PostRunScript
+	}
+
+	v := make([]interface{}, 1)
+	v[0] = flattened
+
+	return v
+}
+
+func expandComputeEnvironmentK8sPlatform(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvK8sPlatformConfig {
+	k8sPlatformConfig := expandComputeEnvironmentKubernetes(d, "k8s_platform")
+
+	k8sPlatformConfig.Server = d.Get("k8s_platform.0.server").(string)
+	k8sPlatformConfig.SslCert = d.Get("k8s_platform.0.ssl_cert").(string)
+
+	return k8sPlatformConfig
+}
+
+func flattenComputeEnvironmentK8sPlatform(ctx context.Context, config *client.ComputeEnvK8sPlatformConfig) []interface{} {
+	flattened := flattenComputeEnvironmentKubernetes(config)
+
+	flattened["server"] = config.Server
+	flattened["ssl_cert"] = config.SslCert
+
+	v := make([]interface{}, 1)
+	v[0] = flattened
+
+	return v
+}
+
+func expandComputeEnvironmentEKSPlatform(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvEKSPlatformConfig {
+	return &client.ComputeEnvEKSPlatformConfig{
+		ComputeEnvK8sPlatformConfig: *expandComputeEnvironmentKubernetes(d, "eks_platform"),
+		Region:                      d.Get("eks_platform.0.region").(string),
+		ClusterName:                 d.Get("eks_platform.0.cluster_name").(string),
+	}
+}
+
+func flattenComputeEnvironmentEKSPlatform(ctx context.Context, config *client.ComputeEnvEKSPlatformConfig) []interface{} {
+	flattened := flattenComputeEnvironmentKubernetes(&config.ComputeEnvK8sPlatformConfig)
+
+	flattened["region"] = config.Region
+	flattened["cluster_name"] = config.ClusterName
+
+	v := make([]interface{}, 1)
+	v[0] = flattened
+
+	return v
+}
+
+func expandComputeEnvironmentGKEPlatform(ctx context.Context, d *schema.ResourceData) *client.ComputeEnvGKEPlatformConfig {
+	return &client.ComputeEnvGKEPlatformConfig{
+		ComputeEnvK8sPlatformConfig: *expandComputeEnvironmentKubernetes(d, "gke_platform"),
+		Region:                      d.Get("gke_platform.0.region").(string),
+		ClusterName:                 d.Get("gke_platform.0.cluster_name").(string),
+	}
+}
+
+func flattenComputeEnvironmentGKEPlatform(ctx context.Context, config *client.ComputeEnvGKEPlatformConfig) []interface{} {
+	flattened := flattenComputeEnvironmentKubernetes(&config.ComputeEnvK8sPlatformConfig)
+
+	flattened["region"] = config.Region
+	flattened["cluster_name"] = config.ClusterName
+
 	v := make([]interface{}, 1)
 	v[0] = flattened
 
 	return v
 }
 
+// expandComputeEnvironmentKubernetes reads the settings shared by the
+// Kubernetes based platforms from the given platform block.
+func expandComputeEnvironmentKubernetes(d *schema.ResourceData, platform string) *client.ComputeEnvK8sPlatformConfig {
+	prefix := platform + ".0."
+
+	k8sConfig := &client.ComputeEnvK8sPlatformConfig{
+		WorkDir:          d.Get(prefix + "work_dir").(string),
+		Namespace:        d.Get(prefix + "namespace").(string),
+		StorageClaimName: d.Get(prefix + "storage_claim_name").(string),
+		Environment:      expandComputeEnvironmentVariables(d),
+	}
+
+	if v, ok := d.GetOk(prefix + "head_service_account"); ok {
+		k8sConfig.HeadServiceAccount = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "compute_service_account"); ok {
+		k8sConfig.ComputeServiceAccount = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "storage_mount_path"); ok {
+		k8sConfig.StorageMountPath = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "pod_cleanup"); ok {
+		k8sConfig.PodCleanup = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "head_pod_spec"); ok {
+		k8sConfig.HeadPodSpec = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "service_pod_spec"); ok {
+		k8sConfig.ServicePodSpec = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "pre_run_script"); ok {
+		k8sConfig.PreRunScript = v.(string)
+	}
+
+	if v, ok := d.GetOk(prefix + "post_run_script"); ok {
+		k8sConfig.PostRunScript = v.(string)
+	}
+
+	return k8sConfig
+}
+
+func flattenComputeEnvironmentKubernetes(config *client.ComputeEnvK8sPlatformConfig) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"work_dir":           config.WorkDir,
+		"namespace":          config.Namespace,
+		"storage_claim_name": config.StorageClaimName,
+	}
+
+	if config.HeadServiceAccount != "" {
+		flattened["head_service_account"] = config.HeadServiceAccount
+	}
+
+	if config.ComputeServiceAccount != "" {
+		flattened["compute_service_account"] = config.ComputeServiceAccount
+	}
+
+	if config.StorageMountPath != "" {
+		flattened["storage_mount_path"] = config.StorageMountPath
+	}
+
+	if config.PodCleanup != "" {
+		flattened["pod_cleanup"] = config.PodCleanup
+	}
+
+	if config.HeadPodSpec != "" {
+		flattened["head_pod_spec"] = config.HeadPodSpec
+	}
+
+	if config.ServicePodSpec != "" {
+		flattened["service_pod_spec"] = config.ServicePodSpec
+	}
+
+	if config.PreRunScript != "" {
+		flattened["pre_run_script"] = config.PreRunScript
+	}
+
+	if config.PostRunScript != "" {
+		flattened["post_run_script"] = config.PostRunScript
+	}
+
+	return flattened
+}
+
 func expandComputeEnvironmentVariables(d *schema.ResourceData) []*client.ComputeEnvConfigEnvVar {
 	vars := d.Get("environment_variable").([]interface{})
 	envVars := make([]*client.ComputeEnvConfigEnvVar, len(vars))
@@ -578,3 +1974,13 @@ func flattenComputeEnvironmentVariables(vars []*client.ComputeEnvConfigEnvVar) [
 
 	return flattened
 }
+
+func expandStringList(list []interface{}) []string {
+	var res []string
+
+	for _, v := range list {
+		res = append(res, v.(string))
+	}
+
+	return res
+}
@@ -2,6 +2,12 @@ package provider
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +24,12 @@ func resourceCredentials() *schema.Resource {
 		UpdateContext: resourceCredentialsUpdate,
 		DeleteContext: resourceCredentialsDelete,
 
+		CustomizeDiff: resourceCredentialsCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description:  "The name of the credentials. Only alphanumeric characters and dashes are allowed.",
@@ -48,13 +60,24 @@ func resourceCredentials() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"keys_fingerprint": {
+				Description: "A salted fingerprint of the keys last written by terraform. Tower never returns the keys, so it is reset when the credentials are changed outside of terraform and the next plan updates them again. Imported credentials have no fingerprint, so the first apply after an import writes the keys from the configuration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"keys_salt": {
+				Description: "The random salt of `keys_fingerprint`, generated the first time terraform writes the keys.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"aws": {
 				Description:   "Stores an AWS IAM access key.",
 				Type:          schema.TypeList,
 				Optional:      true,
 				ForceNew:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"github", "gitlab", "ssh"},
+				ConflictsWith: credentialsProviderConflicts("aws"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"access_key": {
@@ -82,7 +105,7 @@ func resourceCredentials() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"github", "gitlab", "ssh"},
+				ConflictsWith: credentialsProviderConflicts("container_registry"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"username": {
@@ -110,7 +133,7 @@ func resourceCredentials() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"aws", "gitlab", "ssh"},
+				ConflictsWith: credentialsProviderConflicts("github"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"username": {
@@ -138,7 +161,7 @@ func resourceCredentials() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"aws", "github", "ssh"},
+				ConflictsWith: credentialsProviderConflicts("gitlab"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"username": {
@@ -166,13 +189,267 @@ func resourceCredentials() *schema.Resource {
 					},
 				},
 			},
+			"bitbucket": {
+				Description:   "Stores a bitbucket app password.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("bitbucket"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The name of the user that the app password belongs.",
+							Required:    true,
+						},
+						"app_password": {
+							Type:        schema.TypeString,
+							Description: "The app password to use to connect to bitbucket.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url when connecting to bitbucket. Used for bitbucket server on-prem.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"gitea": {
+				Description:   "Stores a gitea password.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("gitea"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The name of the gitea user.",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "The password or access token to use to connect to gitea.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url of the gitea server e.g. https://gitea.example.com.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"azure_repos": {
+				Description:   "Stores an Azure Repos personal access token.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("azure_repos"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The name of the user that the token belongs.",
+							Required:    true,
+						},
+						"access_token": {
+							Type:        schema.TypeString,
+							Description: "The personal access token to use to connect to Azure Repos.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url when connecting to Azure Repos e.g. https://dev.azure.com/my-org.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"codecommit": {
+				Description:   "Stores an AWS IAM access key for AWS CodeCommit.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("codecommit"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": {
+							Type:        schema.TypeString,
+							Description: "The AWS access key.",
+							Required:    true,
+						},
+						"secret_key": {
+							Type:        schema.TypeString,
+							Description: "The AWS secret key.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Description: "The base url of the CodeCommit repositories e.g. https://git-codecommit.eu-west-1.amazonaws.com.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"kubernetes": {
+				Description:   "Stores a Kubernetes service account token or client certificate.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("kubernetes"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:          schema.TypeString,
+							Description:   "The service account token used to connect to the cluster.",
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"kubernetes.0.certificate", "kubernetes.0.private_key"},
+							ExactlyOneOf:  []string{"kubernetes.0.token", "kubernetes.0.certificate"},
+						},
+						"certificate": {
+							Type:          schema.TypeString,
+							Description:   "The PEM encoded client certificate used to connect to the cluster.",
+							Optional:      true,
+							RequiredWith:  []string{"kubernetes.0.private_key"},
+							ConflictsWith: []string{"kubernetes.0.token"},
+						},
+						"private_key": {
+							Type:          schema.TypeString,
+							Description:   "The PEM encoded private key of the client certificate.",
+							Optional:      true,
+							Sensitive:     true,
+							RequiredWith:  []string{"kubernetes.0.certificate"},
+							ConflictsWith: []string{"kubernetes.0.token"},
+						},
+					},
+				},
+			},
+			"tower_agent": {
+				Description:   "Stores the connection of a Tower Agent, used by HPC compute environments that tower cannot reach over SSH.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("tower_agent"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:        schema.TypeString,
+							Description: "The connection id the agent was started with.",
+							Required:    true,
+						},
+						"work_dir": {
+							Type:        schema.TypeString,
+							Description: "The default work directory of the agent.",
+							Optional:    true,
+						},
+						"shared": {
+							Type:        schema.TypeBool,
+							Description: "Whether the agent is shared by all the users of the workspace.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"google": {
+				Description:   "Stores a Google Cloud service account key.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("google"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data": {
+							Type:         schema.TypeString,
+							Description:  "The JSON key of the Google Cloud service account.",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+					},
+				},
+			},
+			"azure": {
+				Description:   "Stores Azure Batch and Storage credentials, either as shared keys or as an Entra service principal.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: credentialsProviderConflicts("azure"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the Azure Batch account.",
+							Required:    true,
+						},
+						"storage_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the Azure Storage account.",
+							Required:    true,
+						},
+						"batch_key": {
+							Type:          schema.TypeString,
+							Description:   "The shared key of the Azure Batch account.",
+							Optional:      true,
+							Sensitive:     true,
+							RequiredWith:  []string{"azure.0.storage_key"},
+							ConflictsWith: []string{"azure.0.tenant_id"},
+							AtLeastOneOf:  []string{"azure.0.batch_key", "azure.0.tenant_id"},
+						},
+						"storage_key": {
+							Type:          schema.TypeString,
+							Description:   "The shared key of the Azure Storage account.",
+							Optional:      true,
+							Sensitive:     true,
+							RequiredWith:  []string{"azure.0.batch_key"},
+							ConflictsWith: []string{"azure.0.tenant_id"},
+						},
+						"tenant_id": {
+							Type:          schema.TypeString,
+							Description:   "The Entra tenant id of the service principal. Changing between shared keys and a service principal creates new credentials.",
+							Optional:      true,
+							ForceNew:      true,
+							RequiredWith:  []string{"azure.0.client_id", "azure.0.client_secret"},
+							ConflictsWith: []string{"azure.0.batch_key", "azure.0.storage_key"},
+						},
+						"client_id": {
+							Type:         schema.TypeString,
+							Description:  "The client id of the Entra service principal.",
+							Optional:     true,
+							RequiredWith: []string{"azure.0.tenant_id"},
+						},
+						"client_secret": {
+							Type:         schema.TypeString,
+							Description:  "The client secret of the Entra service principal.",
+							Optional:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"azure.0.tenant_id"},
+						},
+					},
+				},
+			},
 			"ssh": {
 				Description:   "Stores an SSH private key.",
 				Type:          schema.TypeList,
 				Optional:      true,
 				ForceNew:      true,
 				MaxItems:      1,
-				ConflictsWith: []string{"aws", "github", "gitlab"},
+				ConflictsWith: credentialsProviderConflicts("ssh"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"private_key": {
@@ -194,6 +471,56 @@ func resourceCredentials() *schema.Resource {
 	}
 }
 
+// credentialsProviders lists the mutually exclusive provider blocks of a set
+// of credentials.
+var credentialsProviders = []string{
+	"aws",
+	"azure",
+	"azure_repos",
+	"bitbucket",
+	"codecommit",
+	"container_registry",
+	"gitea",
+	"github",
+	"gitlab",
+	"google",
+	"kubernetes",
+	"ssh",
+	"tower_agent",
+}
+
+func credentialsProviderConflicts(provider string) []string {
+	conflicts := []string{}
+
+	for _, p := range credentialsProviders {
+		if p != provider {
+			conflicts = append(conflicts, p)
+		}
+	}
+
+	return conflicts
+}
+
+func flattenCredentialsTowerAgent(keys client.CredentialKeys) map[string]interface{} {
+	return map[string]interface{}{
+		"connection_id": keys.ConnectionId,
+		"work_dir":      keys.WorkDir,
+		"shared":        keys.Shared,
+	}
+}
+
+// credentialsProvider returns the name of the provider block set on the
+// credentials.
+func credentialsProvider(d *schema.ResourceData) string {
+	for _, p := range credentialsProviders {
+		if _, ok := d.GetOk(p); ok {
+			return p
+		}
+	}
+
+	return "unknown"
+}
+
 func resourceCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	towerClient := meta.(*client.TowerClient)
 	var err error
@@ -240,6 +567,97 @@ func resourceCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta
 			d.Get("gitlab.0.password").(string),
 			d.Get("gitlab.0.token").(string),
 		)
+	} else if _, ok := d.GetOk("google"); ok {
+		id, err = towerClient.CreateCredentialsGoogle(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("google.0.data").(string),
+		)
+	} else if _, ok := d.GetOk("bitbucket"); ok {
+		id, err = towerClient.CreateCredentialsBitbucket(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("bitbucket.0.base_url").(string),
+			d.Get("bitbucket.0.username").(string),
+			d.Get("bitbucket.0.app_password").(string),
+		)
+	} else if _, ok := d.GetOk("gitea"); ok {
+		id, err = towerClient.CreateCredentialsGitea(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("gitea.0.base_url").(string),
+			d.Get("gitea.0.username").(string),
+			d.Get("gitea.0.password").(string),
+		)
+	} else if _, ok := d.GetOk("azure_repos"); ok {
+		id, err = towerClient.CreateCredentialsAzureRepos(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("azure_repos.0.base_url").(string),
+			d.Get("azure_repos.0.username").(string),
+			d.Get("azure_repos.0.access_token").(string),
+		)
+	} else if _, ok := d.GetOk("codecommit"); ok {
+		id, err = towerClient.CreateCredentialsCodeCommit(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("codecommit.0.base_url").(string),
+			d.Get("codecommit.0.access_key").(string),
+			d.Get("codecommit.0.secret_key").(string),
+		)
+	} else if _, ok := d.GetOk("kubernetes"); ok {
+		id, err = towerClient.CreateCredentialsKubernetes(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("kubernetes.0.token").(string),
+			d.Get("kubernetes.0.certificate").(string),
+			d.Get("kubernetes.0.private_key").(string),
+		)
+	} else if _, ok := d.GetOk("tower_agent"); ok {
+		id, err = towerClient.CreateCredentialsTowerAgent(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("tower_agent.0.connection_id").(string),
+			d.Get("tower_agent.0.work_dir").(string),
+			d.Get("tower_agent.0.shared").(bool),
+		)
+	} else if _, ok := d.GetOk("azure.0.tenant_id"); ok {
+		id, err = towerClient.CreateCredentialsAzureEntra(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("azure.0.batch_name").(string),
+			d.Get("azure.0.storage_name").(string),
+			d.Get("azure.0.tenant_id").(string),
+			d.Get("azure.0.client_id").(string),
+			d.Get("azure.0.client_secret").(string),
+		)
+	} else if _, ok := d.GetOk("azure"); ok {
+		id, err = towerClient.CreateCredentialsAzure(
+			ctx,
+			d.Get("workspace_id").(string),
+			d.Get("name").(string),
+			d.Get("description").(string),
+			d.Get("azure.0.batch_name").(string),
+			d.Get("azure.0.batch_key").(string),
+			d.Get("azure.0.storage_name").(string),
+			d.Get("azure.0.storage_key").(string),
+		)
 	} else if _, ok := d.GetOk("ssh"); ok {
 		id, err = towerClient.CreateCredentialsSSH(
 			ctx,
@@ -257,7 +675,7 @@ func resourceCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(id)
 
-	return resourceCredentialsRead(ctx, d, meta)
+	return resourceCredentialsReadAfterWrite(ctx, d, meta)
 }
 
 func resourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -265,7 +683,7 @@ func resourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta a
 
 	credentials, err := towerClient.GetCredentials(ctx, d.Get("workspace_id").(string), d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -274,76 +692,122 @@ func resourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta a
 		return nil
 	}
 
-	d.Set("name", credentials["name"].(string))
+	d.Set("name", credentials.Name)
+	d.Set("description", credentials.Description)
+	d.Set("date_created", credentials.DateCreated)
 
-	if v, ok := credentials["description"].(string); ok {
-		d.Set("description", v)
+	// tower never returns the keys, so a change made outside of terraform can
+	// only be noticed through lastUpdated moving on
+	if lastUpdated := d.Get("last_updated").(string); lastUpdated != "" && lastUpdated != credentials.LastUpdated {
+		d.Set("keys_fingerprint", "")
 	}
 
-	d.Set("date_created", credentials["dateCreated"].(string))
-	d.Set("last_updated", credentials["lastUpdated"].(string))
+	d.Set("last_updated", credentials.LastUpdated)
 
-	keys := credentials["keys"].(map[string]interface{})
-	switch credentials["provider"].(string) {
+	keys := credentials.Keys
+	switch credentials.Provider {
 	case "aws":
-		if assumeRoleArn, ok := keys["assumeRoleArn"].(string); ok {
-			d.Set("aws", []interface{}{
-				map[string]interface{}{
-					"access_key":      keys["accessKey"].(string),
-					"secret_key":      d.Get("aws.0.secret_key").(string),
-					"assume_role_arn": assumeRoleArn,
-				},
-			})
-		} else {
-			d.Set("aws", []interface{}{
-				map[string]interface{}{
-					"access_key": keys["accessKey"].(string),
-					"secret_key": d.Get("aws.0.secret_key").(string),
-				},
-			})
-		}
+		d.Set("aws", []interface{}{
+			map[string]interface{}{
+				"access_key":      keys.AccessKey,
+				"secret_key":      d.Get("aws.0.secret_key").(string),
+				"assume_role_arn": keys.AssumeRoleArn,
+			},
+		})
 	case "container-reg":
-		if registry, ok := keys["registry"].(string); ok {
-			d.Set("container_registry", []interface{}{
-				map[string]interface{}{
-					"username":        keys["userName"].(string),
-					"password":        d.Get("container_registry.0.password").(string),
-					"registry_server": registry,
-				},
-			})
-		} else {
-			d.Set("container_registry", []interface{}{
-				map[string]interface{}{
-					"username": keys["userName"].(string),
-					"password": d.Get("container_registry.0.password").(string),
-				},
-			})
-		}
+		d.Set("container_registry", []interface{}{
+			map[string]interface{}{
+				"username":        keys.Username,
+				"password":        d.Get("container_registry.0.password").(string),
+				"registry_server": keys.Registry,
+			},
+		})
 	case "github":
-		if baseUrl, ok := credentials["baseUrl"].(string); ok {
-			d.Set("github", []interface{}{
-				map[string]interface{}{
-					"username":     keys["username"].(string),
-					"access_token": d.Get("github.0.access_token").(string),
-					"base_url":     baseUrl,
-				},
-			})
-		} else {
-			d.Set("github", []interface{}{
-				map[string]interface{}{
-					"username":     keys["username"].(string),
-					"access_token": d.Get("github.0.access_token").(string),
-				},
-			})
-		}
+		d.Set("github", []interface{}{
+			map[string]interface{}{
+				"username":     keys.Username,
+				"access_token": d.Get("github.0.access_token").(string),
+				"base_url":     credentials.BaseUrl,
+			},
+		})
 	case "gitlab":
 		d.Set("gitlab", []interface{}{
 			map[string]interface{}{
-				"username": keys["username"].(string),
+				"username": keys.Username,
 				"password": d.Get("gitlab.0.password").(string),
 				"token":    d.Get("gitlab.0.token").(string),
+				"base_url": credentials.BaseUrl,
 			},
 		})
+	case "bitbucket":
+		d.Set("bitbucket", []interface{}{
+			map[string]interface{}{
+				"username":     keys.Username,
+				"app_password": d.Get("bitbucket.0.app_password").(string),
+				"base_url":     credentials.BaseUrl,
+			},
+		})
+	case "gitea":
+		d.Set("gitea", []interface{}{
+			map[string]interface{}{
+				"username": keys.Username,
+				"password": d.Get("gitea.0.password").(string),
+				"base_url": credentials.BaseUrl,
+			},
+		})
+	case "azurerepos":
+		d.Set("azure_repos", []interface{}{
+			map[string]interface{}{
+				"username":     keys.Username,
+				"access_token": d.Get("azure_repos.0.access_token").(string),
+				"base_url":     credentials.BaseUrl,
+			},
+		})
+	case "codecommit":
+		d.Set("codecommit", []interface{}{
+			map[string]interface{}{
+				"access_key": keys.Username,
+				"secret_key": d.Get("codecommit.0.secret_key").(string),
+				"base_url":   credentials.BaseUrl,
+			},
+		})
+	case "google":
+		d.Set("google", []interface{}{
+			map[string]interface{}{
+				"data": d.Get("google.0.data").(string),
+			},
+		})
+	case "azure":
+		d.Set("azure", []interface{}{
+			map[string]interface{}{
+				"batch_name":   keys.BatchName,
+				"storage_name": keys.StorageName,
+				"batch_key":    d.Get("azure.0.batch_key").(string),
+				"storage_key":  d.Get("azure.0.storage_key").(string),
+			},
+		})
+	case "azure_entra":
+		d.Set("azure", []interface{}{
+			map[string]interface{}{
+				"batch_name":    keys.BatchName,
+				"storage_name":  keys.StorageName,
+				"tenant_id":     keys.TenantId,
+				"client_id":     keys.ClientId,
+				"client_secret": d.Get("azure.0.client_secret").(string),
+			},
+		})
+	case "k8s":
+		d.Set("kubernetes", []interface{}{
+			map[string]interface{}{
+				"token":       d.Get("kubernetes.0.token").(string),
+				"certificate": d.Get("kubernetes.0.certificate").(string),
+				"private_key": d.Get("kubernetes.0.private_key").(string),
+			},
+		})
+	case "tw-agent":
+		d.Set("tower_agent", []interface{}{
+			flattenCredentialsTowerAgent(keys),
+		})
 	case "ssh":
 		d.Set("ssh", []interface{}{
 			map[string]interface{}{
@@ -352,7 +816,7 @@ func resourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta a
 			},
 		})
 	default:
-		return diag.Errorf("unsupported credentials type %s", credentials["provider"].(string))
+		return diag.Errorf("unsupported credentials type %s", credentials.Provider)
 	}
 
 	return nil
@@ -392,13 +856,205 @@ func resourceCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta
 			d.Get("github.0.username").(string),
 			d.Get("github.0.access_token").(string),
 		)
+	} else if _, ok := d.GetOk("bitbucket"); ok {
+		err = towerClient.UpdateCredentialsBitbucket(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("bitbucket.0.base_url").(string),
+			d.Get("bitbucket.0.username").(string),
+			d.Get("bitbucket.0.app_password").(string),
+		)
+	} else if _, ok := d.GetOk("gitea"); ok {
+		err = towerClient.UpdateCredentialsGitea(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("gitea.0.base_url").(string),
+			d.Get("gitea.0.username").(string),
+			d.Get("gitea.0.password").(string),
+		)
+	} else if _, ok := d.GetOk("azure_repos"); ok {
+		err = towerClient.UpdateCredentialsAzureRepos(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("azure_repos.0.base_url").(string),
+			d.Get("azure_repos.0.username").(string),
+			d.Get("azure_repos.0.access_token").(string),
+		)
+	} else if _, ok := d.GetOk("codecommit"); ok {
+		err = towerClient.UpdateCredentialsCodeCommit(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("codecommit.0.base_url").(string),
+			d.Get("codecommit.0.access_key").(string),
+			d.Get("codecommit.0.secret_key").(string),
+		)
+	} else if _, ok := d.GetOk("kubernetes"); ok {
+		err = towerClient.UpdateCredentialsKubernetes(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("kubernetes.0.token").(string),
+			d.Get("kubernetes.0.certificate").(string),
+			d.Get("kubernetes.0.private_key").(string),
+		)
+	} else if _, ok := d.GetOk("tower_agent"); ok {
+		err = towerClient.UpdateCredentialsTowerAgent(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("tower_agent.0.connection_id").(string),
+			d.Get("tower_agent.0.work_dir").(string),
+			d.Get("tower_agent.0.shared").(bool),
+		)
+	} else if _, ok := d.GetOk("azure.0.tenant_id"); ok {
+		err = towerClient.UpdateCredentialsAzureEntra(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("azure.0.batch_name").(string),
+			d.Get("azure.0.storage_name").(string),
+			d.Get("azure.0.tenant_id").(string),
+			d.Get("azure.0.client_id").(string),
+			d.Get("azure.0.client_secret").(string),
+		)
+	} else if _, ok := d.GetOk("azure"); ok {
+		err = towerClient.UpdateCredentialsAzure(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("azure.0.batch_name").(string),
+			d.Get("azure.0.batch_key").(string),
+			d.Get("azure.0.storage_name").(string),
+			d.Get("azure.0.storage_key").(string),
+		)
+	} else if _, ok := d.GetOk("gitlab"); ok {
+		err = towerClient.UpdateCredentialsGitlab(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("gitlab.0.base_url").(string),
+			d.Get("gitlab.0.username").(string),
+			d.Get("gitlab.0.password").(string),
+			d.Get("gitlab.0.token").(string),
+		)
+	} else if _, ok := d.GetOk("google"); ok {
+		err = towerClient.UpdateCredentialsGoogle(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("google.0.data").(string),
+		)
+	} else if _, ok := d.GetOk("ssh"); ok {
+		err = towerClient.UpdateCredentialsSSH(
+			ctx,
+			d.Id(),
+			d.Get("workspace_id").(string),
+			d.Get("description").(string),
+			d.Get("ssh.0.private_key").(string),
+			d.Get("ssh.0.passphrase").(string),
+		)
+	} else {
+		// every provider block must be handled above, otherwise changes to it
+		// would be silently dropped
+		return diag.Errorf("updating %s credentials is not supported", credentialsProvider(d))
 	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return resourceCredentialsRead(ctx, d, meta)
+	return resourceCredentialsReadAfterWrite(ctx, d, meta)
+}
+
+// resourceCredentialsReadAfterWrite reads the credentials back and records the
+// fingerprint of the keys terraform just wrote. The salt is generated on
+// create, or on the first update of imported credentials, and kept after.
+func resourceCredentialsReadAfterWrite(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	diags := resourceCredentialsRead(ctx, d, meta)
+
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	if d.Get("keys_salt").(string) == "" {
+		salt, err := newCredentialsKeysSalt()
+
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		d.Set("keys_salt", salt)
+	}
+
+	d.Set("keys_fingerprint", credentialsKeysFingerprint(d.Get("keys_salt").(string), d.Get))
+
+	return diags
+}
+
+func resourceCredentialsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.Get("keys_fingerprint").(string) != credentialsKeysFingerprint(d.Get("keys_salt").(string), d.Get) {
+		return d.SetNewComputed("keys_fingerprint")
+	}
+
+	return nil
+}
+
+// newCredentialsKeysSalt returns a random salt for the keys fingerprint.
+func newCredentialsKeysSalt() (string, error) {
+	salt := make([]byte, 16)
+
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating the keys salt: %w", err)
+	}
+
+	return hex.EncodeToString(salt), nil
+}
+
+// credentialsKeysFingerprint hashes the settings of the provider block with a
+// HMAC keyed by the salt of the credentials, so that the fingerprint cannot be
+// matched against the hashes of guessed keys, and equal keys do not share a
+// fingerprint.
+func credentialsKeysFingerprint(salt string, get func(string) interface{}) string {
+	h := hmac.New(sha256.New, []byte(salt))
+
+	for _, p := range credentialsProviders {
+		if l, ok := get(p).([]interface{}); !ok || len(l) == 0 {
+			continue
+		}
+
+		block := get(p + ".0").(map[string]interface{})
+
+		keys := make([]string, 0, len(block))
+		for k := range block {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		fmt.Fprintf(h, "\x00%s", p)
+		for _, k := range keys {
+			fmt.Fprintf(h, "\x00%s=%v", k, block[k])
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 func resourceCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
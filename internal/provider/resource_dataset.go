@@ -18,6 +18,10 @@ func resourceDataset() *schema.Resource {
 		UpdateContext: resourceDatasetUpdate,
 		DeleteContext: resourceDatasetDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description:  "The name of the dataset. Only alphanumeric characters and dashes are allowed.",
@@ -71,11 +75,11 @@ func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*client.TowerClient)
+	towerClient := meta.(*client.TowerClient)
 
-	dataset, err := client.GetDataset(ctx, d.Get("workspace_id").(string), d.Id())
+	dataset, err := towerClient.GetDataset(ctx, d.Get("workspace_id").(string), d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -84,16 +88,12 @@ func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return nil
 	}
 
-	d.Set("name", dataset["name"].(string))
+	d.Set("name", dataset.Name)
 
-	if description, ok := dataset["description"].(string); ok {
-		d.Set("description", description)
-	} else {
-		d.Set("description", nil)
-	}
+	d.Set("description", dataset.Description)
 
-	d.Set("date_created", dataset["dateCreated"].(string))
-	d.Set("last_updated", dataset["lastUpdated"].(string))
+	d.Set("date_created", dataset.DateCreated)
+	d.Set("last_updated", dataset.LastUpdated)
 
 	return nil
 }
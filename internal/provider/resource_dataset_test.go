@@ -27,6 +27,12 @@ func TestAccResourceDataset_basic(t *testing.T) {
 						"nftower_dataset.foo", "last_updated", regexp.MustCompile("^[0-9-:TZ]+")),
 				),
 			},
+			{
+				ResourceName:      "nftower_dataset.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccWorkspaceScopedImportStateIdFunc("nftower_dataset.foo"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
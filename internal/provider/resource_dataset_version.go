@@ -19,6 +19,10 @@ func resourceDatasetVersion() *schema.Resource {
 		ReadContext:   resourceDatasetVersionRead,
 		DeleteContext: schema.NoopContext,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"dataset_id": {
 				Description: "The id of the dataset to upload to.",
@@ -110,16 +114,24 @@ func resourceDatasetVersionRead(ctx context.Context, d *schema.ResourceData, met
 		datasetId,
 		versionId)
 
+	if client.IsNotFound(err) {
+		// the dataset is gone, and its versions with it
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.Set("contents", version["contents"].(string))
-	d.Set("has_header", version["hasHeader"].(bool))
+	d.Set("dataset_id", datasetId)
+	d.Set("file_name", version.FileName)
+	d.Set("contents", version.Contents)
+	d.Set("has_header", version.HasHeader)
 	d.Set("version", versionId)
-	d.Set("last_updated", version["lastUpdated"].(string))
-	d.Set("media_type", version["mediaType"].(string))
-	d.Set("url", version["url"].(string))
+	d.Set("last_updated", version.LastUpdated)
+	d.Set("media_type", version.MediaType)
+	d.Set("url", version.Url)
 
 	return nil
 }
@@ -43,6 +43,12 @@ func TestAccResourceDatasetVersion_csv(t *testing.T) {
 						"nftower_dataset_version.foo", "url", regexp.MustCompile("^"+apiUrl+"/workspaces/[0-9]+/datasets/[0-9A-Za-z]+/v/[0-9]+/n/foo.csv$")),
 				),
 			},
+			{
+				ResourceName:      "nftower_dataset_version.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccWorkspaceScopedImportStateIdFunc("nftower_dataset_version.foo"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
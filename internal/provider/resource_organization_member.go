@@ -20,6 +20,10 @@ func resourceOrganizationMember() *schema.Resource {
 		UpdateContext: resourceOrganizationMemberUpdate,
 		DeleteContext: resourceOrganizationMemberDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportIntegerId,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Description: "The email address of the user to add.",
@@ -72,11 +76,24 @@ func resourceOrganizationMemberCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*client.TowerClient)
+	towerClient := meta.(*client.TowerClient)
 
-	member, err := client.GetOrganizationMember(ctx, d.Get("email").(string))
+	var member *client.OrganizationMember
+	var err error
 
-	if err != nil {
+	if email, ok := d.GetOk("email"); ok {
+		member, err = towerClient.GetOrganizationMember(ctx, email.(string))
+	} else {
+		// imported resources only know their member id
+		memberId, parseErr := resourceParseIntegerId(d.Id())
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
+
+		member, err = towerClient.GetOrganizationMemberById(ctx, memberId)
+	}
+
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -85,17 +102,13 @@ func resourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceData,
 		return nil
 	}
 
-	d.Set("email", member["email"].(string))
-	d.Set("user_name", member["userName"].(string))
+	d.Set("email", member.Email)
+	d.Set("user_name", member.UserName)
 
-	if v, ok := member["firstName"].(string); ok {
-		d.Set("first_name", v)
-	}
-	if v, ok := member["lastName"].(string); ok {
-		d.Set("last_name", v)
-	}
+	d.Set("first_name", member.FirstName)
+	d.Set("last_name", member.LastName)
 
-	d.Set("role", member["role"].(string))
+	d.Set("role", member.Role)
 
 	return nil
 }
@@ -25,6 +25,17 @@ func TestAccResourceOrganizationMember(t *testing.T) {
 						"nftower_organization_member.foo", "role", "member"),
 				),
 			},
+			{
+				ResourceName:      "nftower_organization_member.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "nftower_organization_member.foo",
+				ImportState:   true,
+				ImportStateId: "foo",
+				ExpectError:   regexp.MustCompile("Expected id to be an integer"),
+			},
 		},
 	})
 }
@@ -19,6 +19,10 @@ func resourcePipeline() *schema.Resource {
 		UpdateContext: resourcePipelineUpdate,
 		DeleteContext: resourcePipelineDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
 				Description: "The id of the workspace in which the pipeline should be created.",
@@ -165,7 +169,7 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	pipeline, err := c.GetPipeline(ctx, d.Get("workspace_id").(string), d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -174,60 +178,11 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return nil
 	}
 
-	d.Set("name", pipeline["name"].(string))
-	d.Set("pipeline", pipeline["pipeline"].(string))
-	d.Set("work_dir", pipeline["workDir"].(string))
-
-	computeEnv := pipeline["computeEnv"].(map[string]interface{})
-	d.Set("compute_environment_id", computeEnv["id"].(string))
-
-	if v, ok := pipeline["revision"].(string); ok {
-		d.Set("revision", v)
-	}
-
-	if v, ok := pipeline["preRunScript"].(string); ok {
-		d.Set("pre_run_script", v)
-	}
-
-	if v, ok := pipeline["postRunScript"].(string); ok {
-		d.Set("post_run_script", v)
-	}
-
-	if v, ok := pipeline["configProfiles"].([]interface{}); ok {
-		d.Set("config_profiles", v)
-	}
-
-	if v, ok := pipeline["paramsText"].(string); ok {
-		d.Set("pipeline_parameters", v)
-	}
+	d.Set("name", pipeline.Name)
+	d.Set("description", pipeline.Description)
+	d.Set("labels", flattenLabels(pipeline.Labels))
 
-	if v, ok := pipeline["configText"].(string); ok {
-		d.Set("nextflow_config", v)
-	}
-
-	if v, ok := pipeline["towerConfig"].(string); ok {
-		d.Set("tower_config", v)
-	}
-
-	if v, ok := pipeline["mainScript"].(string); ok {
-		d.Set("main_script", v)
-	}
-
-	if v, ok := pipeline["entryName"].(string); ok {
-		d.Set("workflow_entry_name", v)
-	}
-
-	if v, ok := pipeline["schema_name"].(string); ok {
-		d.Set("schema_name", v)
-	}
-
-	if v, ok := pipeline["workspaceSecrets"].([]interface{}); ok {
-		d.Set("workspace_secrets", v)
-	}
-
-	if v, ok := pipeline["labels"].([]interface{}); ok {
-		d.Set("labels", flattenLabels(v))
-	}
+	setLaunchAttributes(d, pipeline.Launch)
 
 	return nil
 }
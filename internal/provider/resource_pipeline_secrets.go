@@ -17,6 +17,10 @@ func resourcePipelineSecrets() *schema.Resource {
 		UpdateContext: resourcePipelineSecretsUpdate,
 		DeleteContext: resourcePipelineSecretsDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScoped,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The name of the pipeline-secret.",
@@ -75,7 +79,7 @@ func resourcePipelineSecretsRead(ctx context.Context, d *schema.ResourceData, me
 
 	pipelineSecret, err := towerClient.GetPipelineSecret(ctx, d.Get("workspace_id").(string), d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -84,14 +88,12 @@ func resourcePipelineSecretsRead(ctx context.Context, d *schema.ResourceData, me
 		return nil
 	}
 
-	d.Set("name", pipelineSecret["name"].(string))
+	d.Set("name", pipelineSecret.Name)
 
-	if v, ok := pipelineSecret["lastUsed"].(string); ok {
-		d.Set("last_used", v)
-	}
+	d.Set("last_used", pipelineSecret.LastUsed)
 
-	d.Set("date_created", pipelineSecret["dateCreated"].(string))
-	d.Set("last_updated", pipelineSecret["lastUpdated"].(string))
+	d.Set("date_created", pipelineSecret.DateCreated)
+	d.Set("last_updated", pipelineSecret.LastUpdated)
 
 	return nil
 }
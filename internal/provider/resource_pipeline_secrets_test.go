@@ -27,6 +27,13 @@ func TestAccResourcePipelineSecrets(t *testing.T) {
 						"nftower_pipeline_secrets.foo", "last_updated", regexp.MustCompile("^[0-9-:TZ]+")),
 				),
 			},
+			{
+				ResourceName:            "nftower_pipeline_secrets.foo",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccWorkspaceScopedImportStateIdFunc("nftower_pipeline_secrets.foo"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
 		},
 	})
 }
@@ -16,6 +16,10 @@ func resourceToken() *schema.Resource {
 		ReadContext:   resourceTokenRead,
 		DeleteContext: resourceTokenDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The name of the action",
@@ -57,7 +61,7 @@ func resourceTokenRead(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	token, err := c.GetToken(ctx, d.Id())
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -66,8 +70,8 @@ func resourceTokenRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return nil
 	}
 
-	d.Set("name", token["name"].(string))
-	d.Set("date_created", token["dateCreated"].(string))
+	d.Set("name", token.Name)
+	d.Set("date_created", token.DateCreated)
 
 	return nil
 }
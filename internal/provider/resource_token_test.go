@@ -25,6 +25,12 @@ func TestAccResourceToken(t *testing.T) {
 						"nftower_token.foo", "date_created", regexp.MustCompile("^[0-9-:TZ]+")),
 				),
 			},
+			{
+				ResourceName:            "nftower_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
 		},
 	})
 }
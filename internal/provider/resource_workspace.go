@@ -20,6 +20,10 @@ func resourceWorkspace() *schema.Resource {
 		UpdateContext: resourceWorkspaceUpdate,
 		DeleteContext: resourceWorkspaceDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description:  "The name of the workspace. Only alphanumeric characters and dashes are allowed.",
@@ -82,12 +86,12 @@ func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*client.TowerClient)
+	towerClient := meta.(*client.TowerClient)
 
 	workspaceId, _ := strconv.ParseInt(d.Id(), 10, 64)
-	workspace, err := client.GetWorkspace(ctx, workspaceId)
+	workspace, err := towerClient.GetWorkspace(ctx, workspaceId)
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -96,18 +100,12 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta any
 		return nil
 	}
 
-	d.Set("name", workspace["name"].(string))
-	d.Set("full_name", workspace["fullName"].(string))
-
-	if description, ok := workspace["description"].(string); ok {
-		d.Set("description", description)
-	} else {
-		d.Set("description", nil)
-	}
-
-	d.Set("visibility", workspace["visibility"].(string))
-	d.Set("date_created", workspace["dateCreated"].(string))
-	d.Set("last_updated", workspace["lastUpdated"].(string))
+	d.Set("name", workspace.Name)
+	d.Set("full_name", workspace.FullName)
+	d.Set("description", workspace.Description)
+	d.Set("visibility", workspace.Visibility)
+	d.Set("date_created", workspace.DateCreated)
+	d.Set("last_updated", workspace.LastUpdated)
 
 	return nil
 }
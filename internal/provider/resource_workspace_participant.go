@@ -20,6 +20,10 @@ func resourceWorkspaceParticipant() *schema.Resource {
 		UpdateContext: resourceWorkspaceParticipantUpdate,
 		DeleteContext: resourceWorkspaceParticipantDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWorkspaceScopedIntegerId,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
 				Description: "The id of the workspace to grant access to.",
@@ -92,7 +96,7 @@ func resourceWorkspaceParticipantCreate(ctx context.Context, d *schema.ResourceD
 			return diag.Errorf("no member found in organization with email %s", email)
 		}
 
-		memberId = int64(member["memberId"].(float64))
+		memberId = member.MemberId
 	}
 
 	if memberId == 0 {
@@ -118,13 +122,28 @@ func resourceWorkspaceParticipantCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceWorkspaceParticipantRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*client.TowerClient)
+	towerClient := meta.(*client.TowerClient)
+
+	var participant *client.WorkspaceParticipant
+	var err error
+
+	if email, ok := d.GetOk("email"); ok {
+		participant, err = towerClient.GetWorkspaceParticipantByMemberEmail(ctx,
+			d.Get("workspace_id").(string),
+			email.(string))
+	} else {
+		// imported resources only know their participant id
+		participantId, parseErr := resourceParseIntegerId(d.Id())
+		if parseErr != nil {
+			return diag.FromErr(parseErr)
+		}
 
-	participant, err := client.GetWorkspaceParticipantByMemberEmail(ctx,
-		d.Get("workspace_id").(string),
-		d.Get("email").(string))
+		participant, err = towerClient.GetWorkspaceParticipantById(ctx,
+			d.Get("workspace_id").(string),
+			participantId)
+	}
 
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -133,14 +152,12 @@ func resourceWorkspaceParticipantRead(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
-	if v, ok := participant["firstName"].(string); ok {
-		d.Set("first_name", v)
-	}
-	if v, ok := participant["lastName"].(string); ok {
-		d.Set("last_name", v)
-	}
+	d.Set("first_name", participant.FirstName)
+	d.Set("last_name", participant.LastName)
 
-	d.Set("role", participant["wspRole"].(string))
+	d.Set("email", participant.Email)
+	d.Set("member_id", fmt.Sprintf("%d", participant.MemberId))
+	d.Set("role", participant.WspRole)
 
 	return nil
 }
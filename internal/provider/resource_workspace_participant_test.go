@@ -23,6 +23,12 @@ func TestAccResourceWorkspaceParticipant_basic(t *testing.T) {
 						"nftower_workspace_participant.foo", "role", "view"),
 				),
 			},
+			{
+				ResourceName:      "nftower_workspace_participant.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccWorkspaceScopedImportStateIdFunc("nftower_workspace_participant.foo"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
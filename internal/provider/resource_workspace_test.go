@@ -29,6 +29,11 @@ func TestAccResourceWorkspace(t *testing.T) {
 						"nftower_workspace.foo", "last_updated", regexp.MustCompile("^[0-9-:TZ]+")),
 				),
 			},
+			{
+				ResourceName:      "nftower_workspace.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/healx/terraform-provider-nftower/internal/client"
+)
+
+// The sweepers delete the objects left behind by failed acceptance test runs
+// in the organization of NFTOWER_ORGANIZATION. Run them with
+//
+//	go test ./internal/provider -v -sweep=all
+//
+// The value of -sweep is required by the test framework but is not used, as
+// tower has no regions.
+//
+// Every object created by the acceptance tests either lives in a workspace
+// whose name starts with testAccPrefix, or is named with that prefix itself.
+// Objects inside such workspaces are deleted whatever their name.
+
+const testAccPrefix = "tf-acceptance-"
+
+func init() {
+	resource.AddTestSweepers("nftower_action", &resource.Sweeper{
+		Name: "nftower_action",
+		F:    testSweepActions,
+	})
+
+	resource.AddTestSweepers("nftower_pipeline", &resource.Sweeper{
+		Name: "nftower_pipeline",
+		F:    testSweepPipelines,
+	})
+
+	resource.AddTestSweepers("nftower_compute_environment", &resource.Sweeper{
+		Name:         "nftower_compute_environment",
+		Dependencies: []string{"nftower_action", "nftower_pipeline"},
+		F:            testSweepComputeEnvs,
+	})
+
+	resource.AddTestSweepers("nftower_credentials", &resource.Sweeper{
+		Name:         "nftower_credentials",
+		Dependencies: []string{"nftower_compute_environment"},
+		F:            testSweepCredentials,
+	})
+
+	// dataset versions cannot be deleted, they are removed with their dataset
+	resource.AddTestSweepers("nftower_dataset", &resource.Sweeper{
+		Name: "nftower_dataset",
+		F:    testSweepDatasets,
+	})
+
+	resource.AddTestSweepers("nftower_pipeline_secrets", &resource.Sweeper{
+		Name: "nftower_pipeline_secrets",
+		F:    testSweepPipelineSecrets,
+	})
+
+	resource.AddTestSweepers("nftower_workspace_participant", &resource.Sweeper{
+		Name: "nftower_workspace_participant",
+		F:    testSweepWorkspaceParticipants,
+	})
+
+	resource.AddTestSweepers("nftower_workspace", &resource.Sweeper{
+		Name: "nftower_workspace",
+		Dependencies: []string{
+			"nftower_credentials",
+			"nftower_dataset",
+			"nftower_pipeline_secrets",
+			"nftower_workspace_participant",
+		},
+		F: testSweepWorkspaces,
+	})
+
+	resource.AddTestSweepers("nftower_organization_member", &resource.Sweeper{
+		Name:         "nftower_organization_member",
+		Dependencies: []string{"nftower_workspace_participant"},
+		F:            testSweepOrganizationMembers,
+	})
+
+	resource.AddTestSweepers("nftower_token", &resource.Sweeper{
+		Name: "nftower_token",
+		F:    testSweepTokens,
+	})
+}
+
+// sharedClientForSweepers configures the provider from the environment, as
+// the acceptance tests do, and returns its client.
+func sharedClientForSweepers() (*client.TowerClient, error) {
+	p := New("sweeper")()
+
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return nil, fmt.Errorf("unable to configure the provider: %v", diags)
+	}
+
+	return p.Meta().(*client.TowerClient), nil
+}
+
+// testSweepAcceptanceWorkspaces calls sweep for each workspace created by the
+// acceptance tests, carrying on when it fails for one of them.
+func testSweepAcceptanceWorkspaces(sweep func(ctx context.Context, c *client.TowerClient, workspaceId string) error) error {
+	ctx := context.Background()
+
+	c, err := sharedClientForSweepers()
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := c.ListWorkspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list workspaces: %w", err)
+	}
+
+	var errs []error
+
+	for _, w := range workspaces {
+		if !strings.HasPrefix(w.Name, testAccPrefix) {
+			continue
+		}
+
+		if err := sweep(ctx, c, fmt.Sprintf("%d", w.Id)); err != nil {
+			errs = append(errs, fmt.Errorf("workspace %s: %w", w.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func testSweepActions(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		actions, err := c.ListActions(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, a := range actions {
+			log.Printf("[INFO] Deleting action %s (%s)", a.Name, a.Id)
+			errs = append(errs, c.DeleteAction(ctx, workspaceId, a.Id))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepPipelines(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		pipelines, err := c.ListPipelines(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, p := range pipelines {
+			log.Printf("[INFO] Deleting pipeline %s (%d)", p.Name, p.PipelineId)
+			errs = append(errs, c.DeletePipeline(ctx, workspaceId, fmt.Sprintf("%d", p.PipelineId)))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepComputeEnvs(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		computeEnvs, err := c.ListComputeEnvs(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, e := range computeEnvs {
+			log.Printf("[INFO] Deleting compute environment %s (%s)", e.Name, e.Id)
+			errs = append(errs, c.DeleteComputeEnv(ctx, workspaceId, e.Id))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepCredentials(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		credentials, err := c.ListCredentials(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, cr := range credentials {
+			log.Printf("[INFO] Deleting credentials %s (%s)", cr.Name, cr.Id)
+			errs = append(errs, c.DeleteCredentials(ctx, workspaceId, cr.Id))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepDatasets(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		datasets, err := c.ListDatasets(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, d := range datasets {
+			log.Printf("[INFO] Deleting dataset %s (%s)", d.Name, d.Id)
+			errs = append(errs, c.DeleteDataset(ctx, workspaceId, d.Id))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepPipelineSecrets(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		secrets, err := c.ListPipelineSecrets(ctx, workspaceId)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, s := range secrets {
+			log.Printf("[INFO] Deleting pipeline secret %s (%d)", s.Name, s.Id)
+			errs = append(errs, c.DeletePipelineSecrets(ctx, workspaceId, fmt.Sprintf("%d", s.Id)))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepWorkspaceParticipants(_ string) error {
+	return testSweepAcceptanceWorkspaces(func(ctx context.Context, c *client.TowerClient, workspaceId string) error {
+		participants, err := c.GetWorkspaceParticipants(ctx, workspaceId, map[string]string{})
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, p := range participants {
+			// keep the owner of the workspace, i.e. the user running the
+			// sweepers
+			if !strings.HasPrefix(p.Email, testAccPrefix) {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting workspace participant %s (%d)", p.Email, p.ParticipantId)
+			errs = append(errs, c.DeleteWorkspaceParticipant(ctx, workspaceId, p.ParticipantId))
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func testSweepWorkspaces(_ string) error {
+	ctx := context.Background()
+
+	c, err := sharedClientForSweepers()
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := c.ListWorkspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list workspaces: %w", err)
+	}
+
+	var errs []error
+	for _, w := range workspaces {
+		if !strings.HasPrefix(w.Name, testAccPrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting workspace %s (%d)", w.Name, w.Id)
+		errs = append(errs, c.DeleteWorkspace(ctx, w.Id))
+	}
+
+	return errors.Join(errs...)
+}
+
+func testSweepOrganizationMembers(_ string) error {
+	ctx := context.Background()
+
+	c, err := sharedClientForSweepers()
+	if err != nil {
+		return err
+	}
+
+	members, err := c.ListOrganizationMembers(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list organization members: %w", err)
+	}
+
+	var errs []error
+	for _, m := range members {
+		if !strings.HasPrefix(m.Email, testAccPrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting organization member %s (%d)", m.Email, m.MemberId)
+		errs = append(errs, c.DeleteOrganizationMember(ctx, m.MemberId))
+	}
+
+	return errors.Join(errs...)
+}
+
+func testSweepTokens(_ string) error {
+	ctx := context.Background()
+
+	c, err := sharedClientForSweepers()
+	if err != nil {
+		return err
+	}
+
+	tokens, err := c.ListTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list tokens: %w", err)
+	}
+
+	var errs []error
+	for _, t := range tokens {
+		if !strings.HasPrefix(t.Name, testAccPrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting token %s (%d)", t.Name, t.Id)
+		errs = append(errs, c.DeleteToken(ctx, fmt.Sprintf("%d", t.Id)))
+	}
+
+	return errors.Join(errs...)
+}
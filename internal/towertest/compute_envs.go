@@ -0,0 +1,260 @@
+package towertest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerComputeEnvs() {
+	s.handle("GET", "/compute-envs", s.listComputeEnvs)
+	s.handle("POST", "/compute-envs", s.createComputeEnv)
+	s.handle("GET", "/compute-envs/primary", s.getPrimaryComputeEnv)
+	s.handle("GET", "/compute-envs/{computeEnvId}", s.getComputeEnv)
+	s.handle("POST", "/compute-envs/{computeEnvId}/primary", s.setPrimaryComputeEnv)
+	s.handle("PUT", "/compute-envs/{computeEnvId}", s.updateComputeEnv)
+	s.handle("DELETE", "/compute-envs/{computeEnvId}", s.deleteComputeEnv)
+}
+
+// computeEnvCreation describes how a new compute environment comes up: it is
+// reported CREATING for polls reads, then ends up with status and message.
+type computeEnvCreation struct {
+	polls   int
+	status  string
+	message string
+}
+
+// SetComputeEnvCreation makes the compute environments created from now on
+// report CREATING for the given number of reads before they end up with
+// status, along with message. By default compute environments are AVAILABLE
+// as soon as they are created.
+func (s *Server) SetComputeEnvCreation(polls int, status string, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.computeEnvCreation = computeEnvCreation{polls: polls, status: status, message: message}
+}
+
+// computeEnv returns the compute environment with the given id. Deleted
+// compute environments are still returned, flagged as deleted, like tower
+// does.
+func (s *Server) computeEnv(w http.ResponseWriter, ws *workspace, id string) object {
+	computeEnv, ok := ws.computeEnvs[id]
+
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Compute environment %s not found", id))
+		return nil
+	}
+
+	return computeEnv
+}
+
+func (s *Server) listComputeEnvs(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	computeEnvs := []object{}
+	for _, computeEnv := range sorted(ws.computeEnvs) {
+		if computeEnv["deleted"] == true {
+			continue
+		}
+
+		// the list only contains a summary of each compute environment
+		computeEnvs = append(computeEnvs, copyObject(computeEnv, "id", "name", "platform", "status", "message", "primary", "lastUsed", "workDir", "credentialsId"))
+	}
+
+	// tower does not paginate compute environments
+	writeJSON(w, http.StatusOK, object{"computeEnvs": computeEnvs})
+}
+
+func (s *Server) createComputeEnv(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		ComputeEnv object `json:"computeEnv"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, computeEnv := range ws.computeEnvs {
+		if computeEnv["deleted"] != true && computeEnv["name"] == body.ComputeEnv["name"] {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A compute environment with name '%s' already exists", computeEnv["name"]))
+			return
+		}
+	}
+
+	if _, ok := ws.credentials[fmt.Sprint(body.ComputeEnv["credentialsId"])]; !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Credentials %v not found", body.ComputeEnv["credentialsId"]))
+		return
+	}
+
+	id := randomId()
+	computeEnv := copyObject(body.ComputeEnv)
+	computeEnv["id"] = id
+	computeEnv["status"] = "AVAILABLE"
+	computeEnv["message"] = nil
+	computeEnv["primary"] = false
+	computeEnv["deleted"] = false
+	computeEnv["dateCreated"] = now()
+	computeEnv["lastUpdated"] = now()
+	computeEnv["lastUsed"] = nil
+
+	ws.computeEnvs[id] = computeEnv
+
+	if creation := s.computeEnvCreation; creation.polls > 0 {
+		computeEnv["status"] = "CREATING"
+		ws.creating[id] = &creation
+	} else if creation.status != "" {
+		setComputeEnvStatus(computeEnv, creation)
+	}
+
+	writeJSON(w, http.StatusOK, object{"computeEnvId": id})
+}
+
+func (s *Server) getComputeEnv(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	computeEnv := s.computeEnv(w, ws, p["computeEnvId"])
+	if computeEnv == nil {
+		return
+	}
+
+	if creation, ok := ws.creating[p["computeEnvId"]]; ok {
+		creation.polls--
+		if creation.polls < 0 {
+			setComputeEnvStatus(computeEnv, *creation)
+			delete(ws.creating, p["computeEnvId"])
+		}
+	}
+
+	computeEnv["primary"] = ws.primaryId == computeEnv["id"]
+
+	writeJSON(w, http.StatusOK, object{"computeEnv": computeEnv})
+}
+
+// setComputeEnvStatus moves a compute environment out of CREATING.
+func setComputeEnvStatus(computeEnv object, creation computeEnvCreation) {
+	computeEnv["status"] = "AVAILABLE"
+	if creation.status != "" {
+		computeEnv["status"] = creation.status
+	}
+
+	if creation.message != "" {
+		computeEnv["message"] = creation.message
+	}
+}
+
+func (s *Server) getPrimaryComputeEnv(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	computeEnv, ok := ws.computeEnvs[ws.primaryId]
+
+	if !ok || computeEnv["deleted"] == true {
+		writeJSON(w, http.StatusOK, object{})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{
+		"computeEnv": copyObject(computeEnv, "id", "name", "platform"),
+	})
+}
+
+func (s *Server) setPrimaryComputeEnv(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	computeEnv := s.computeEnv(w, ws, p["computeEnvId"])
+	if computeEnv == nil {
+		return
+	}
+
+	ws.primaryId = computeEnv["id"].(string)
+
+	writeNoContent(w)
+}
+
+func (s *Server) updateComputeEnv(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	computeEnv := s.computeEnv(w, ws, p["computeEnvId"])
+	if computeEnv == nil {
+		return
+	}
+
+	// tower only lets the name, description, credentials, scripts and
+	// environment variables be edited, the rest of the config is ignored
+	var body struct {
+		Name          string `json:"name"`
+		Description   string `json:"description"`
+		CredentialsId string `json:"credentialsId"`
+		Config        struct {
+			PreRunScript  string        `json:"preRunScript"`
+			PostRunScript string        `json:"postRunScript"`
+			Environment   []interface{} `json:"environment"`
+		} `json:"config"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if _, ok := ws.credentials[body.CredentialsId]; !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Credentials %v not found", body.CredentialsId))
+		return
+	}
+
+	computeEnv["name"] = body.Name
+	computeEnv["description"] = body.Description
+	computeEnv["credentialsId"] = body.CredentialsId
+
+	config, _ := computeEnv["config"].(map[string]interface{})
+	if config == nil {
+		config = map[string]interface{}{}
+		computeEnv["config"] = config
+	}
+	config["preRunScript"] = body.Config.PreRunScript
+	config["postRunScript"] = body.Config.PostRunScript
+	config["environment"] = body.Config.Environment
+
+	computeEnv["lastUpdated"] = now()
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteComputeEnv(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	computeEnv := s.computeEnv(w, ws, p["computeEnvId"])
+	if computeEnv == nil {
+		return
+	}
+
+	computeEnv["deleted"] = true
+	computeEnv["status"] = "DELETING"
+
+	if ws.primaryId == computeEnv["id"] {
+		ws.primaryId = ""
+	}
+
+	writeNoContent(w)
+}
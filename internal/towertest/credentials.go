@@ -0,0 +1,167 @@
+package towertest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// publicCredentialKeys are the keys tower returns when reading credentials.
+// The secret keys are write only.
+var publicCredentialKeys = []string{
+	"accessKey",
+	"assumeRoleArn",
+	"username",
+	"userName",
+	"registry",
+	"batchName",
+	"storageName",
+	"tenantId",
+	"clientId",
+	"connectionId",
+	"workDir",
+	"shared",
+}
+
+func (s *Server) registerCredentials() {
+	s.handle("GET", "/credentials", s.listCredentials)
+	s.handle("POST", "/credentials", s.createCredentials)
+	s.handle("GET", "/credentials/{credentialsId}", s.getCredentials)
+	s.handle("PUT", "/credentials/{credentialsId}", s.updateCredentials)
+	s.handle("DELETE", "/credentials/{credentialsId}", s.deleteCredentials)
+}
+
+// credentials returns the credentials with the given id. Tower answers 403
+// rather than 404 for credentials which do not exist.
+func (s *Server) credentials(w http.ResponseWriter, ws *workspace, id string) object {
+	credentials, ok := ws.credentials[id]
+
+	if !ok {
+		writeError(w, http.StatusForbidden, "Forbidden")
+		return nil
+	}
+
+	return credentials
+}
+
+// publicCredentials returns credentials without their secret keys.
+func publicCredentials(credentials object) object {
+	public := copyObject(credentials)
+
+	keys, _ := credentials["keys"].(map[string]interface{})
+	public["keys"] = copyObject(keys, publicCredentialKeys...)
+
+	return public
+}
+
+func (s *Server) listCredentials(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	credentials := []object{}
+	for _, c := range sorted(ws.credentials) {
+		credentials = append(credentials, copyObject(c, "id", "name", "description", "provider", "baseUrl", "dateCreated", "lastUpdated", "lastUsed"))
+	}
+
+	// tower does not paginate credentials
+	writeJSON(w, http.StatusOK, object{"credentials": credentials})
+}
+
+func (s *Server) createCredentials(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		Credentials object `json:"credentials"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, c := range ws.credentials {
+		if c["name"] == body.Credentials["name"] {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Credentials with name '%s' already exist", c["name"]))
+			return
+		}
+	}
+
+	id := randomId()
+	credentials := copyObject(body.Credentials)
+	credentials["id"] = id
+	credentials["dateCreated"] = now()
+	credentials["lastUpdated"] = now()
+	credentials["lastUsed"] = nil
+
+	ws.credentials[id] = credentials
+
+	writeJSON(w, http.StatusOK, object{"credentialsId": id})
+}
+
+func (s *Server) getCredentials(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	credentials := s.credentials(w, ws, p["credentialsId"])
+	if credentials == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"credentials": publicCredentials(credentials)})
+}
+
+func (s *Server) updateCredentials(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	credentials := s.credentials(w, ws, p["credentialsId"])
+	if credentials == nil {
+		return
+	}
+
+	var body struct {
+		Credentials object `json:"credentials"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.Credentials["provider"] != credentials["provider"] {
+		writeError(w, http.StatusBadRequest, "The provider of credentials cannot be changed")
+		return
+	}
+
+	for _, k := range []string{"description", "baseUrl", "keys"} {
+		if v, ok := body.Credentials[k]; ok {
+			credentials[k] = v
+		}
+	}
+
+	credentials["lastUpdated"] = now()
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteCredentials(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	credentials := s.credentials(w, ws, p["credentialsId"])
+	if credentials == nil {
+		return
+	}
+
+	delete(ws.credentials, credentials["id"].(string))
+
+	writeNoContent(w)
+}
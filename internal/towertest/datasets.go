@@ -0,0 +1,237 @@
+package towertest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+func (s *Server) registerDatasets() {
+	s.handle("GET", "/workspaces/{workspaceId}/datasets", s.listDatasets)
+	s.handle("POST", "/workspaces/{workspaceId}/datasets", s.createDataset)
+	s.handle("GET", "/workspaces/{workspaceId}/datasets/{datasetId}/metadata", s.getDataset)
+	s.handle("PUT", "/workspaces/{workspaceId}/datasets/{datasetId}", s.updateDataset)
+	s.handle("DELETE", "/workspaces/{workspaceId}/datasets/{datasetId}", s.deleteDataset)
+	s.handle("POST", "/workspaces/{workspaceId}/datasets/{datasetId}/upload", s.uploadDatasetVersion)
+	s.handle("GET", "/workspaces/{workspaceId}/datasets/{datasetId}/versions", s.listDatasetVersions)
+	s.handle("GET", "/workspaces/{workspaceId}/datasets/{datasetId}/v/{version}/n/{fileName}", s.downloadDatasetVersion)
+}
+
+func (s *Server) dataset(w http.ResponseWriter, ws *workspace, id string) object {
+	dataset, ok := ws.datasets[id]
+
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Dataset %s not found", id))
+		return nil
+	}
+
+	return dataset
+}
+
+func (s *Server) listDatasets(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	datasets := []object{}
+	for _, dataset := range sorted(ws.datasets) {
+		if dataset["deleted"] == true {
+			continue
+		}
+
+		datasets = append(datasets, dataset)
+	}
+
+	// tower does not paginate datasets
+	writeJSON(w, http.StatusOK, object{"datasets": datasets})
+}
+
+func (s *Server) createDataset(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, dataset := range ws.datasets {
+		if dataset["deleted"] != true && dataset["name"] == body.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A dataset with name '%s' already exists", body.Name))
+			return
+		}
+	}
+
+	id := randomId()
+	dataset := object{
+		"id":          id,
+		"name":        body.Name,
+		"description": body.Description,
+		"mediaType":   nil,
+		"deleted":     false,
+		"dateCreated": now(),
+		"lastUpdated": now(),
+	}
+
+	ws.datasets[id] = dataset
+
+	writeJSON(w, http.StatusOK, object{"dataset": dataset})
+}
+
+func (s *Server) getDataset(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	dataset := s.dataset(w, ws, p["datasetId"])
+	if dataset == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"dataset": dataset})
+}
+
+func (s *Server) updateDataset(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	dataset := s.dataset(w, ws, p["datasetId"])
+	if dataset == nil {
+		return
+	}
+
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	dataset["name"] = body.Name
+	dataset["description"] = body.Description
+	dataset["lastUpdated"] = now()
+
+	writeNoContent(w)
+}
+
+// deleteDataset flags the dataset as deleted, like tower does.
+func (s *Server) deleteDataset(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	dataset := s.dataset(w, ws, p["datasetId"])
+	if dataset == nil {
+		return
+	}
+
+	dataset["deleted"] = true
+
+	writeNoContent(w)
+}
+
+func (s *Server) uploadDatasetVersion(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	dataset := s.dataset(w, ws, p["datasetId"])
+	if dataset == nil {
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid upload: %s", err))
+		return
+	}
+	defer file.Close()
+
+	contents, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid upload: %s", err))
+		return
+	}
+
+	id := dataset["id"].(string)
+	number := len(ws.versions[id]) + 1
+	mediaType := header.Header.Get("Content-Type")
+
+	version := object{
+		"datasetId":   id,
+		"version":     number,
+		"hasHeader":   r.URL.Query().Get("header") == "true",
+		"fileName":    header.Filename,
+		"mediaType":   mediaType,
+		"url":         fmt.Sprintf("%s/workspaces/%s/datasets/%s/v/%d/n/%s", baseUrl(r), p["workspaceId"], id, number, header.Filename),
+		"dateCreated": now(),
+		"lastUpdated": now(),
+	}
+
+	ws.versions[id] = append(ws.versions[id], version)
+	ws.contents[id] = append(ws.contents[id], contents)
+
+	dataset["mediaType"] = mediaType
+	dataset["lastUpdated"] = now()
+
+	writeJSON(w, http.StatusOK, object{"version": version})
+}
+
+func (s *Server) listDatasetVersions(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	dataset := s.dataset(w, ws, p["datasetId"])
+	if dataset == nil {
+		return
+	}
+
+	versions := ws.versions[dataset["id"].(string)]
+	if versions == nil {
+		versions = []object{}
+	}
+
+	// tower does not paginate dataset versions
+	writeJSON(w, http.StatusOK, object{"versions": versions})
+}
+
+func (s *Server) downloadDatasetVersion(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	dataset := s.dataset(w, ws, p["datasetId"])
+	if dataset == nil {
+		return
+	}
+
+	id := dataset["id"].(string)
+	number, err := strconv.Atoi(p["version"])
+
+	if err != nil || number < 1 || number > len(ws.versions[id]) || ws.versions[id][number-1]["fileName"] != p["fileName"] {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Version %s of dataset %s not found", p["version"], id))
+		return
+	}
+
+	w.Header().Set("Content-Type", ws.versions[id][number-1]["mediaType"].(string))
+	w.WriteHeader(http.StatusOK)
+	w.Write(ws.contents[id][number-1])
+}
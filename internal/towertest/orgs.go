@@ -0,0 +1,433 @@
+package towertest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) registerOrgs() {
+	s.handle("GET", "/orgs", s.listOrgs)
+
+	s.handle("GET", "/orgs/{orgId}/workspaces", s.listWorkspaces)
+	s.handle("POST", "/orgs/{orgId}/workspaces", s.createWorkspace)
+	s.handle("GET", "/orgs/{orgId}/workspaces/{workspaceId}", s.getWorkspace)
+	s.handle("PUT", "/orgs/{orgId}/workspaces/{workspaceId}", s.updateWorkspace)
+	s.handle("DELETE", "/orgs/{orgId}/workspaces/{workspaceId}", s.deleteWorkspace)
+
+	s.handle("GET", "/orgs/{orgId}/members", s.listMembers)
+	s.handle("PUT", "/orgs/{orgId}/members/add", s.addMember)
+	s.handle("PUT", "/orgs/{orgId}/members/{memberId}/role", s.updateMemberRole)
+	s.handle("DELETE", "/orgs/{orgId}/members/{memberId}", s.deleteMember)
+
+	s.handle("GET", "/orgs/{orgId}/workspaces/{workspaceId}/participants", s.listParticipants)
+	s.handle("PUT", "/orgs/{orgId}/workspaces/{workspaceId}/participants/add", s.addParticipant)
+	s.handle("PUT", "/orgs/{orgId}/workspaces/{workspaceId}/participants/{participantId}/role", s.updateParticipantRole)
+	s.handle("DELETE", "/orgs/{orgId}/workspaces/{workspaceId}/participants/{participantId}", s.deleteParticipant)
+
+	s.handle("GET", "/tokens", s.listTokens)
+	s.handle("POST", "/tokens", s.createToken)
+	s.handle("DELETE", "/tokens/{tokenId}", s.deleteToken)
+}
+
+func (s *Server) listOrgs(w http.ResponseWriter, r *http.Request, p params) {
+	writePage(w, r, "organizations", []object{
+		{
+			"orgId":    s.orgId,
+			"name":     Organization,
+			"fullName": Organization,
+		},
+	})
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	workspaces := []object{}
+	for _, ws := range s.sortedWorkspaces() {
+		if !isDeletedWorkspace(ws) {
+			workspaces = append(workspaces, ws.object)
+		}
+	}
+
+	writePage(w, r, "workspaces", workspaces)
+}
+
+func (s *Server) sortedWorkspaces() []*workspace {
+	workspaces := []*workspace{}
+	for id := int64(1); id <= s.lastId; id++ {
+		if ws, ok := s.workspaces[id]; ok {
+			workspaces = append(workspaces, ws)
+		}
+	}
+
+	return workspaces
+}
+
+// isDeletedWorkspace reports whether the workspace has been deleted. Like
+// tower, deleted workspaces are kept around under a deleted- name.
+func isDeletedWorkspace(ws *workspace) bool {
+	return strings.HasPrefix(ws.object["name"].(string), "deleted-")
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	var body struct {
+		Workspace struct {
+			Name        string `json:"name"`
+			FullName    string `json:"fullName"`
+			Description string `json:"description"`
+			Visibility  string `json:"visibility"`
+		} `json:"workspace"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, ws := range s.workspaces {
+		if ws.object["name"] == body.Workspace.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A workspace with name '%s' already exists", body.Workspace.Name))
+			return
+		}
+	}
+
+	id := s.nextId()
+	ws := &workspace{
+		object: object{
+			"id":          id,
+			"name":        body.Workspace.Name,
+			"fullName":    body.Workspace.FullName,
+			"description": body.Workspace.Description,
+			"visibility":  body.Workspace.Visibility,
+			"dateCreated": now(),
+			"lastUpdated": now(),
+		},
+		participants: map[int64]object{},
+		computeEnvs:  map[string]object{},
+		creating:     map[string]*computeEnvCreation{},
+		credentials:  map[string]object{},
+		labels:       map[int64]object{},
+		pipelines:    map[int64]object{},
+		launches:     map[string]object{},
+		actions:      map[string]object{},
+		datasets:     map[string]object{},
+		versions:     map[string][]object{},
+		contents:     map[string][][]byte{},
+		secrets:      map[int64]object{},
+	}
+
+	s.workspaces[id] = ws
+
+	writeJSON(w, http.StatusOK, object{"workspace": ws.object})
+}
+
+func (s *Server) getWorkspace(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"workspace": ws.object})
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		FullName    string `json:"fullName"`
+		Description string `json:"description"`
+		Visibility  string `json:"visibility"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	ws.object["fullName"] = body.FullName
+	ws.object["description"] = body.Description
+	ws.object["visibility"] = body.Visibility
+	ws.object["lastUpdated"] = now()
+
+	writeJSON(w, http.StatusOK, object{"workspace": ws.object})
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	if !isDeletedWorkspace(ws) {
+		ws.object["name"] = fmt.Sprintf("deleted-%d-%s", ws.object["id"], ws.object["name"])
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) listMembers(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	writePage(w, r, "members", search(r, sorted(s.members), "email", "userName", "firstName", "lastName"))
+}
+
+func (s *Server) addMember(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	var body struct {
+		User string `json:"user"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, member := range s.members {
+		if member["email"] == body.User {
+			writeError(w, http.StatusConflict, fmt.Sprintf("User '%s' is already a member of this organization", body.User))
+			return
+		}
+	}
+
+	id := s.nextId()
+	member := object{
+		"memberId":  id,
+		"userId":    s.nextId(),
+		"userName":  strings.Split(body.User, "@")[0],
+		"email":     body.User,
+		"firstName": nil,
+		"lastName":  nil,
+		"role":      "member",
+	}
+
+	s.members[id] = member
+
+	writeJSON(w, http.StatusOK, object{"member": member})
+}
+
+func (s *Server) updateMemberRole(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	member, ok := s.members[parseId(p["memberId"])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Member not found")
+		return
+	}
+
+	var body struct {
+		Role string `json:"role"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	member["role"] = body.Role
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteMember(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	id := parseId(p["memberId"])
+	if _, ok := s.members[id]; !ok {
+		writeError(w, http.StatusNotFound, "Member not found")
+		return
+	}
+
+	delete(s.members, id)
+
+	// members removed from the organization leave all of its workspaces
+	for _, ws := range s.workspaces {
+		for participantId, participant := range ws.participants {
+			if participant["memberId"] == id {
+				delete(ws.participants, participantId)
+			}
+		}
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) listParticipants(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	writePage(w, r, "participants", search(r, sorted(ws.participants), "email", "userName", "firstName", "lastName"))
+}
+
+func (s *Server) addParticipant(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		MemberId int64 `json:"memberId"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	member, ok := s.members[body.MemberId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Member not found")
+		return
+	}
+
+	for _, participant := range ws.participants {
+		if participant["memberId"] == body.MemberId {
+			writeError(w, http.StatusConflict, "Member is already a participant of this workspace")
+			return
+		}
+	}
+
+	id := s.nextId()
+	participant := copyObject(member, "memberId", "userName", "email", "firstName", "lastName")
+	participant["participantId"] = id
+	participant["orgRole"] = member["role"]
+	participant["wspRole"] = "launch"
+	participant["type"] = "MEMBER"
+
+	ws.participants[id] = participant
+
+	writeJSON(w, http.StatusOK, object{"participant": participant})
+}
+
+func (s *Server) updateParticipantRole(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	participant, ok := ws.participants[parseId(p["participantId"])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Participant not found")
+		return
+	}
+
+	var body struct {
+		Role string `json:"role"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	participant["wspRole"] = body.Role
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteParticipant(w http.ResponseWriter, r *http.Request, p params) {
+	if !s.checkOrg(w, p) {
+		return
+	}
+
+	ws := s.workspace(w, p["workspaceId"])
+	if ws == nil {
+		return
+	}
+
+	id := parseId(p["participantId"])
+	if _, ok := ws.participants[id]; !ok {
+		writeError(w, http.StatusNotFound, "Participant not found")
+		return
+	}
+
+	delete(ws.participants, id)
+
+	writeNoContent(w)
+}
+
+func (s *Server) listTokens(w http.ResponseWriter, r *http.Request, p params) {
+	writePage(w, r, "tokens", sorted(s.tokens))
+}
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Name string `json:"name"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, token := range s.tokens {
+		if token["name"] == body.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A token with name '%s' already exists", body.Name))
+			return
+		}
+	}
+
+	id := s.nextId()
+	token := object{
+		"id":          id,
+		"name":        body.Name,
+		"dateCreated": now(),
+		"lastUsed":    nil,
+	}
+
+	s.tokens[id] = token
+
+	writeJSON(w, http.StatusOK, object{
+		"token":     token,
+		"accessKey": randomId(),
+	})
+}
+
+func (s *Server) deleteToken(w http.ResponseWriter, r *http.Request, p params) {
+	id := parseId(p["tokenId"])
+	if _, ok := s.tokens[id]; !ok {
+		writeError(w, http.StatusNotFound, "Token not found")
+		return
+	}
+
+	delete(s.tokens, id)
+
+	writeNoContent(w)
+}
@@ -0,0 +1,138 @@
+package towertest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerPipelineSecrets() {
+	s.handle("GET", "/pipeline-secrets", s.listPipelineSecrets)
+	s.handle("POST", "/pipeline-secrets", s.createPipelineSecret)
+	s.handle("GET", "/pipeline-secrets/{secretId}", s.getPipelineSecret)
+	s.handle("PUT", "/pipeline-secrets/{secretId}", s.updatePipelineSecret)
+	s.handle("DELETE", "/pipeline-secrets/{secretId}", s.deletePipelineSecret)
+}
+
+// pipelineSecret returns the secret with the given id. Tower answers 403
+// rather than 404 for secrets which do not exist.
+func (s *Server) pipelineSecret(w http.ResponseWriter, ws *workspace, id string) object {
+	secret, ok := ws.secrets[parseId(id)]
+
+	if !ok {
+		writeError(w, http.StatusForbidden, "Forbidden")
+		return nil
+	}
+
+	return secret
+}
+
+// publicPipelineSecret returns the secret without its value, which is write
+// only.
+func publicPipelineSecret(secret object) object {
+	return copyObject(secret, "id", "name", "dateCreated", "lastUpdated", "lastUsed")
+}
+
+func (s *Server) listPipelineSecrets(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	secrets := []object{}
+	for _, secret := range sorted(ws.secrets) {
+		secrets = append(secrets, publicPipelineSecret(secret))
+	}
+
+	// tower does not paginate pipeline secrets
+	writeJSON(w, http.StatusOK, object{"pipelineSecrets": secrets})
+}
+
+func (s *Server) createPipelineSecret(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, secret := range ws.secrets {
+		if secret["name"] == body.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A secret with name '%s' already exists", body.Name))
+			return
+		}
+	}
+
+	id := s.nextId()
+	ws.secrets[id] = object{
+		"id":          id,
+		"name":        body.Name,
+		"value":       body.Value,
+		"dateCreated": now(),
+		"lastUpdated": now(),
+		"lastUsed":    nil,
+	}
+
+	writeJSON(w, http.StatusOK, object{"secretId": id})
+}
+
+func (s *Server) getPipelineSecret(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	secret := s.pipelineSecret(w, ws, p["secretId"])
+	if secret == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"pipelineSecret": publicPipelineSecret(secret)})
+}
+
+func (s *Server) updatePipelineSecret(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	secret := s.pipelineSecret(w, ws, p["secretId"])
+	if secret == nil {
+		return
+	}
+
+	var body struct {
+		Value string `json:"value"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	secret["value"] = body.Value
+	secret["lastUpdated"] = now()
+
+	writeNoContent(w)
+}
+
+func (s *Server) deletePipelineSecret(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	secret := s.pipelineSecret(w, ws, p["secretId"])
+	if secret == nil {
+		return
+	}
+
+	delete(ws.secrets, secret["id"].(int64))
+
+	writeNoContent(w)
+}
@@ -0,0 +1,425 @@
+package towertest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerPipelines() {
+	s.handle("GET", "/labels", s.listLabels)
+	s.handle("POST", "/labels", s.createLabel)
+
+	s.handle("GET", "/pipelines", s.listPipelines)
+	s.handle("POST", "/pipelines", s.createPipeline)
+	s.handle("GET", "/pipelines/{pipelineId}", s.getPipeline)
+	s.handle("GET", "/pipelines/{pipelineId}/launch", s.getPipelineLaunch)
+	s.handle("PUT", "/pipelines/{pipelineId}", s.updatePipeline)
+	s.handle("DELETE", "/pipelines/{pipelineId}", s.deletePipeline)
+
+	s.handle("GET", "/actions", s.listActions)
+	s.handle("POST", "/actions", s.createAction)
+	s.handle("GET", "/actions/{actionId}", s.getAction)
+	s.handle("PUT", "/actions/{actionId}", s.updateAction)
+	s.handle("DELETE", "/actions/{actionId}", s.deleteAction)
+}
+
+func (s *Server) listLabels(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	writePage(w, r, "labels", search(r, sorted(ws.labels), "name"))
+}
+
+func (s *Server) createLabel(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	var body struct {
+		Name string `json:"name"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, label := range ws.labels {
+		if label["name"] == body.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A label with name '%s' already exists", body.Name))
+			return
+		}
+	}
+
+	id := s.nextId()
+	label := object{
+		"id":       id,
+		"name":     body.Name,
+		"value":    nil,
+		"resource": false,
+	}
+
+	ws.labels[id] = label
+
+	writeJSON(w, http.StatusOK, label)
+}
+
+// labelsFromIds returns the labels with the given ids, ignoring the ids of
+// labels that do not exist.
+func labelsFromIds(ws *workspace, ids []int64) []object {
+	labels := []object{}
+
+	for _, id := range ids {
+		if label, ok := ws.labels[id]; ok {
+			labels = append(labels, label)
+		}
+	}
+
+	return labels
+}
+
+// newLaunch stores the launch settings shared by pipelines and actions.
+func (s *Server) newLaunch(w http.ResponseWriter, ws *workspace, settings object) object {
+	computeEnv, ok := ws.computeEnvs[fmt.Sprint(settings["computeEnvId"])]
+
+	if !ok || computeEnv["deleted"] == true {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Compute environment %v not found", settings["computeEnvId"]))
+		return nil
+	}
+
+	launch := copyObject(settings)
+	delete(launch, "computeEnvId")
+	delete(launch, "labelsIds")
+
+	if _, ok := launch["id"]; !ok {
+		launch["id"] = randomId()
+	}
+	launch["computeEnv"] = copyObject(computeEnv, "id", "name", "platform")
+	launch["dateCreated"] = now()
+
+	ws.launches[launch["id"].(string)] = launch
+
+	return launch
+}
+
+func (s *Server) pipeline(w http.ResponseWriter, ws *workspace, id string) object {
+	pipeline, ok := ws.pipelines[parseId(id)]
+
+	if !ok {
+		// tower answers 403 rather than 404 for pipelines which do not exist
+		writeError(w, http.StatusForbidden, "Forbidden")
+		return nil
+	}
+
+	return pipeline
+}
+
+// pipelineSummary returns the pipeline the way tower lists it, without its
+// launch settings.
+func pipelineSummary(ws *workspace, pipeline object) object {
+	summary := copyObject(pipeline, "pipelineId", "name", "description", "repository")
+	summary["labels"] = labelsFromIds(ws, pipeline["labelsIds"].([]int64))
+
+	return summary
+}
+
+type pipelineBody struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	LabelsIds   []int64 `json:"labelsIds"`
+	Launch      object  `json:"launch"`
+}
+
+func (s *Server) listPipelines(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	pipelines := []object{}
+	for _, pipeline := range search(r, sorted(ws.pipelines), "name") {
+		pipelines = append(pipelines, pipelineSummary(ws, pipeline))
+	}
+
+	writePage(w, r, "pipelines", pipelines)
+}
+
+func (s *Server) createPipeline(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	var body pipelineBody
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, pipeline := range ws.pipelines {
+		if pipeline["name"] == body.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("A pipeline with name '%s' already exists", body.Name))
+			return
+		}
+	}
+
+	launch := s.newLaunch(w, ws, body.Launch)
+	if launch == nil {
+		return
+	}
+
+	id := s.nextId()
+	pipeline := object{
+		"pipelineId":  id,
+		"name":        body.Name,
+		"description": body.Description,
+		"repository":  launch["pipeline"],
+		"labelsIds":   body.LabelsIds,
+		"launchId":    launch["id"],
+	}
+
+	ws.pipelines[id] = pipeline
+
+	writeJSON(w, http.StatusOK, object{"pipeline": pipelineSummary(ws, pipeline)})
+}
+
+func (s *Server) getPipeline(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	pipeline := s.pipeline(w, ws, p["pipelineId"])
+	if pipeline == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"pipeline": pipelineSummary(ws, pipeline)})
+}
+
+func (s *Server) getPipelineLaunch(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	pipeline := s.pipeline(w, ws, p["pipelineId"])
+	if pipeline == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"launch": ws.launches[pipeline["launchId"].(string)]})
+}
+
+func (s *Server) updatePipeline(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	pipeline := s.pipeline(w, ws, p["pipelineId"])
+	if pipeline == nil {
+		return
+	}
+
+	var body pipelineBody
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	// updating a pipeline creates a new launch
+	launch := s.newLaunch(w, ws, body.Launch)
+	if launch == nil {
+		return
+	}
+
+	delete(ws.launches, pipeline["launchId"].(string))
+
+	pipeline["description"] = body.Description
+	pipeline["labelsIds"] = body.LabelsIds
+	pipeline["repository"] = launch["pipeline"]
+	pipeline["launchId"] = launch["id"]
+
+	writeJSON(w, http.StatusOK, object{"pipeline": pipelineSummary(ws, pipeline)})
+}
+
+func (s *Server) deletePipeline(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	pipeline := s.pipeline(w, ws, p["pipelineId"])
+	if pipeline == nil {
+		return
+	}
+
+	delete(ws.launches, pipeline["launchId"].(string))
+	delete(ws.pipelines, pipeline["pipelineId"].(int64))
+
+	writeNoContent(w)
+}
+
+func (s *Server) action(w http.ResponseWriter, ws *workspace, id string) object {
+	action, ok := ws.actions[id]
+
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Action %s not found", id))
+		return nil
+	}
+
+	return action
+}
+
+type actionBody struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	Launch object `json:"launch"`
+}
+
+// labelsIds returns the labelsIds of a launch request.
+func labelsIds(launch object) []int64 {
+	ids := []int64{}
+
+	values, _ := launch["labelsIds"].([]interface{})
+	for _, v := range values {
+		if id, ok := v.(float64); ok {
+			ids = append(ids, int64(id))
+		}
+	}
+
+	return ids
+}
+
+func (s *Server) createAction(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	var body actionBody
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, action := range ws.actions {
+		if action["name"] == body.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("An action with name '%s' already exists", body.Name))
+			return
+		}
+	}
+
+	launch := s.newLaunch(w, ws, body.Launch)
+	if launch == nil {
+		return
+	}
+
+	id := randomId()
+	action := object{
+		"id":          id,
+		"name":        body.Name,
+		"source":      body.Source,
+		"status":      "ACTIVE",
+		"hookId":      nil,
+		"hookUrl":     nil,
+		"launchId":    launch["id"],
+		"labelsIds":   labelsIds(body.Launch),
+		"dateCreated": now(),
+		"lastUpdated": now(),
+	}
+
+	if body.Source == "tower" {
+		action["hookUrl"] = fmt.Sprintf("%s/actions/%s/launch?workspaceId=%d", baseUrl(r), id, ws.object["id"])
+	}
+
+	ws.actions[id] = action
+
+	writeJSON(w, http.StatusOK, object{"actionId": id})
+}
+
+func (s *Server) listActions(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	actions := []object{}
+	for _, action := range sorted(ws.actions) {
+		actions = append(actions, copyObject(action, "id", "name", "source", "status", "hookId", "hookUrl", "dateCreated", "lastUpdated"))
+	}
+
+	// tower does not paginate actions
+	writeJSON(w, http.StatusOK, object{"actions": actions})
+}
+
+func (s *Server) getAction(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	action := s.action(w, ws, p["actionId"])
+	if action == nil {
+		return
+	}
+
+	response := copyObject(action, "id", "name", "source", "status", "hookId", "hookUrl", "dateCreated", "lastUpdated")
+	response["launch"] = ws.launches[action["launchId"].(string)]
+	response["labels"] = labelsFromIds(ws, action["labelsIds"].([]int64))
+
+	writeJSON(w, http.StatusOK, object{"action": response})
+}
+
+func (s *Server) updateAction(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	action := s.action(w, ws, p["actionId"])
+	if action == nil {
+		return
+	}
+
+	var body actionBody
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	launch := s.newLaunch(w, ws, body.Launch)
+	if launch == nil {
+		return
+	}
+
+	if launch["id"] != action["launchId"] {
+		delete(ws.launches, action["launchId"].(string))
+	}
+
+	action["launchId"] = launch["id"]
+	action["labelsIds"] = labelsIds(body.Launch)
+	action["lastUpdated"] = now()
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteAction(w http.ResponseWriter, r *http.Request, p params) {
+	ws := s.queryWorkspace(w, r)
+	if ws == nil {
+		return
+	}
+
+	action := s.action(w, ws, p["actionId"])
+	if action == nil {
+		return
+	}
+
+	delete(ws.launches, action["launchId"].(string))
+	delete(ws.actions, action["id"].(string))
+
+	writeNoContent(w)
+}
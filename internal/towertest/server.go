@@ -0,0 +1,329 @@
+// Package towertest provides an in-memory fake of the Tower API, so the
+// acceptance tests can run without a Tower account.
+//
+// The fake implements the endpoints used by the provider closely enough for
+// the provider to manage resources against it, including the quirks the
+// client relies on, like tower answering 403 for deleted credentials.
+package towertest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// APIKey is the only api key accepted by the server.
+	APIKey = "towertest"
+
+	// Organization is the name of the organization the server is created
+	// with.
+	Organization = "tf-acceptance"
+)
+
+// object is a Tower API object, as it is returned in a response.
+type object map[string]interface{}
+
+type params map[string]string
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, p params)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// Server is a fake Tower API listening on a local address. Point the
+// provider's api_url at Server.URL.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	routes []route
+	lastId int64
+
+	orgId      int64
+	workspaces map[int64]*workspace
+	members    map[int64]object
+	tokens     map[int64]object
+
+	// computeEnvCreation is how the compute environments created next come
+	// up, set with SetComputeEnvCreation
+	computeEnvCreation computeEnvCreation
+}
+
+// workspace holds the objects which live inside a workspace.
+type workspace struct {
+	object object
+
+	participants map[int64]object
+	computeEnvs  map[string]object
+	creating     map[string]*computeEnvCreation
+	primaryId    string
+	credentials  map[string]object
+	labels       map[int64]object
+	pipelines    map[int64]object
+	launches     map[string]object
+	actions      map[string]object
+	datasets     map[string]object
+	versions     map[string][]object
+	contents     map[string][][]byte
+	secrets      map[int64]object
+}
+
+// NewServer starts a fake Tower API with a single organization named
+// Organization. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		workspaces: map[int64]*workspace{},
+		members:    map[int64]object{},
+		tokens:     map[int64]object{},
+	}
+
+	s.orgId = s.nextId()
+
+	s.registerOrgs()
+	s.registerComputeEnvs()
+	s.registerCredentials()
+	s.registerPipelines()
+	s.registerDatasets()
+	s.registerPipelineSecrets()
+
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+func (s *Server) handle(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+APIKey {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	for _, route := range s.routes {
+		if route.method != r.Method {
+			continue
+		}
+
+		if p, ok := match(route.segments, segments); ok {
+			route.handler(w, r, p)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("Unknown endpoint %s %s", r.Method, r.URL.Path))
+}
+
+func match(pattern []string, segments []string) (params, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	p := params{}
+
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			p[strings.Trim(segment, "{}")] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+func (s *Server) nextId() int64 {
+	s.lastId++
+	return s.lastId
+}
+
+// randomId returns an id in the format tower uses for compute environments,
+// credentials, actions and datasets.
+func randomId() string {
+	const alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	id := make([]byte, 22)
+	for i := range id {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+		if err != nil {
+			panic(err)
+		}
+		id[i] = alphabet[n.Int64()]
+	}
+
+	return string(id)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// baseUrl returns the url the request was sent to, without its path.
+func baseUrl(r *http.Request) string {
+	return fmt.Sprintf("http://%s", r.Host)
+}
+
+func parseId(v string) int64 {
+	id, _ := strconv.ParseInt(v, 10, 64)
+	return id
+}
+
+// workspace returns the workspace with the given id, or writes a 403 the way
+// tower does when the workspace does not exist.
+func (s *Server) workspace(w http.ResponseWriter, id string) *workspace {
+	ws, ok := s.workspaces[parseId(id)]
+
+	if !ok {
+		writeError(w, http.StatusForbidden, "Forbidden")
+		return nil
+	}
+
+	return ws
+}
+
+// queryWorkspace returns the workspace named by the workspaceId query
+// parameter.
+func (s *Server) queryWorkspace(w http.ResponseWriter, r *http.Request) *workspace {
+	return s.workspace(w, r.URL.Query().Get("workspaceId"))
+}
+
+func (s *Server) checkOrg(w http.ResponseWriter, p params) bool {
+	if parseId(p["orgId"]) != s.orgId {
+		writeError(w, http.StatusForbidden, "Forbidden")
+		return false
+	}
+
+	return true
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{"message": message})
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writePage writes a page of items, honouring the max and offset query
+// parameters the way tower's list endpoints do.
+func writePage(w http.ResponseWriter, r *http.Request, key string, items []object) {
+	query := r.URL.Query()
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	max, err := strconv.Atoi(query.Get("max"))
+	if err != nil || max <= 0 {
+		max = len(items)
+	}
+
+	page := []object{}
+	if offset < len(items) {
+		end := offset + max
+		if end > len(items) {
+			end = len(items)
+		}
+		page = items[offset:end]
+	}
+
+	writeJSON(w, http.StatusOK, object{
+		key:         page,
+		"totalSize": len(items),
+	})
+}
+
+// search keeps the items where any of the given fields contains the search
+// query parameter.
+func search(r *http.Request, items []object, fields ...string) []object {
+	term := strings.ToLower(r.URL.Query().Get("search"))
+
+	if term == "" {
+		return items
+	}
+
+	found := []object{}
+
+	for _, item := range items {
+		for _, field := range fields {
+			if v, ok := item[field].(string); ok && strings.Contains(strings.ToLower(v), term) {
+				found = append(found, item)
+				break
+			}
+		}
+	}
+
+	return found
+}
+
+// sorted returns the values of m ordered by key, so that pages are stable.
+func sorted[K int64 | string](m map[K]object) []object {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	values := make([]object, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, m[k])
+	}
+
+	return values
+}
+
+// copyObject returns a shallow copy of o, with only the given keys when any
+// are given.
+func copyObject(o object, keys ...string) object {
+	c := object{}
+
+	if len(keys) == 0 {
+		for k, v := range o {
+			c[k] = v
+		}
+		return c
+	}
+
+	for _, k := range keys {
+		if v, ok := o[k]; ok {
+			c[k] = v
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,205 @@
+package towertest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/healx/terraform-provider-nftower/internal/client"
+)
+
+func newTestClient(t *testing.T) *client.TowerClient {
+	t.Helper()
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := client.NewTowerClient(context.Background(), "towertest", APIKey, server.URL, Organization)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return c
+}
+
+func newTestWorkspace(t *testing.T, c *client.TowerClient) string {
+	t.Helper()
+
+	id, err := c.CreateWorkspace(context.Background(), "towertest", "towertest", "", "PRIVATE")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return fmt.Sprintf("%d", id)
+}
+
+func TestServerRejectsUnknownApiKey(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, err := client.NewTowerClient(context.Background(), "towertest", "unknown", server.URL, Organization)
+	if err == nil {
+		t.Fatal("expected an error for an unknown api key")
+	}
+}
+
+func TestServerWorkspaces(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	id, err := c.CreateWorkspace(ctx, "foo", "Foo", "A workspace", "PRIVATE")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := c.CreateWorkspace(ctx, "foo", "Foo", "", "PRIVATE"); !client.IsConflict(err) {
+		t.Fatalf("expected a conflict, got: %v", err)
+	}
+
+	workspace, err := c.GetWorkspaceByName(ctx, "foo")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if workspace.Id != id || workspace.FullName != "Foo" || workspace.Description != "A workspace" {
+		t.Fatalf("unexpected workspace: %+v", workspace)
+	}
+
+	if err := c.DeleteWorkspace(ctx, id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	workspace, err = c.GetWorkspace(ctx, id)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if workspace != nil {
+		t.Fatalf("expected the workspace to be deleted, got: %+v", workspace)
+	}
+}
+
+func TestServerCredentials(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	workspaceId := newTestWorkspace(t, c)
+
+	id, err := c.CreateCredentialsAWS(ctx, workspaceId, "aws", "", "NOTANACCESSKEY", "secret", "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	credentials, err := c.GetCredentialsByName(ctx, workspaceId, "aws")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if credentials.Id != id || credentials.Provider != "aws" || credentials.Keys.AccessKey != "NOTANACCESSKEY" {
+		t.Fatalf("unexpected credentials: %+v", credentials)
+	}
+
+	if err := c.DeleteCredentials(ctx, workspaceId, id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	credentials, err = c.GetCredentials(ctx, workspaceId, id)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if credentials != nil {
+		t.Fatalf("expected the credentials to be deleted, got: %+v", credentials)
+	}
+}
+
+func TestServerPaginatesTokens(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	var id string
+	for i := 0; i < 150; i++ {
+		var err error
+		id, _, err = c.CreateToken(ctx, fmt.Sprintf("token-%d", i))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	token, err := c.GetToken(ctx, id)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token == nil || token.Name != "token-149" {
+		t.Fatalf("unexpected token: %+v", token)
+	}
+}
+
+func TestServerDatasetVersions(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	workspaceId := newTestWorkspace(t, c)
+
+	datasetId, err := c.CreateDataset(ctx, workspaceId, "foo", "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	contents := "one,two\n1,2\n"
+
+	version, err := c.CreateDatasetVersion(ctx, workspaceId, datasetId, contents, "foo.csv", true)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	datasetVersion, err := c.GetDatasetVersion(ctx, workspaceId, datasetId, version)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if datasetVersion.Contents != contents || datasetVersion.MediaType != "text/csv" || !datasetVersion.HasHeader {
+		t.Fatalf("unexpected dataset version: %+v", datasetVersion)
+	}
+}
+
+func TestServerComputeEnvCreation(t *testing.T) {
+	ctx := context.Background()
+
+	server := NewServer()
+	defer server.Close()
+
+	c, err := client.NewTowerClient(ctx, "towertest", APIKey, server.URL, Organization)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	workspaceId := newTestWorkspace(t, c)
+
+	credentialsId, err := c.CreateCredentialsAWS(ctx, workspaceId, "aws", "", "NOTANACCESSKEY", "secret", "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	server.SetComputeEnvCreation(2, "ERRORED", "Unable to find the compute queue")
+
+	id, err := c.CreateAWSBatchComputeEnv(ctx, workspaceId, "aws", "", credentialsId, &client.ComputeEnvAWSBatchConfig{
+		Region:       "eu-west-1",
+		ComputeQueue: "compute",
+		HeadQueue:    "head",
+		WorkDir:      "s3://somebucket/",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, status := range []string{"CREATING", "CREATING", "ERRORED", "ERRORED"} {
+		computeEnv, err := c.GetComputeEnv(ctx, workspaceId, id)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if computeEnv.Status != status {
+			t.Fatalf("expected status %s, got %s", status, computeEnv.Status)
+		}
+	}
+
+	computeEnv, err := c.GetComputeEnv(ctx, workspaceId, id)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if computeEnv.Message != "Unable to find the compute queue" {
+		t.Fatalf("unexpected message: %s", computeEnv.Message)
+	}
+}